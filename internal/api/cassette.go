@@ -0,0 +1,290 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// cassetteVersion is the file format version written to cassettes
+const cassetteVersion = 1
+
+// ErrCassetteMiss is returned in replay mode for requests the cassette
+// has no recorded response for
+var ErrCassetteMiss = errors.New("no recorded response in cassette")
+
+// cassetteFile is the on-disk format of a recorded session
+type cassetteFile struct {
+	Version      int                   `json:"version"`
+	Interactions []cassetteInteraction `json:"interactions"`
+}
+
+// cassetteInteraction is one recorded GraphQL request/response pair
+type cassetteInteraction struct {
+	Key       string          `json:"key"`
+	Operation string          `json:"operation"`
+	Query     string          `json:"query"`
+	Variables json.RawMessage `json:"variables,omitempty"`
+	Status    int             `json:"status"`
+	Response  json.RawMessage `json:"response"`
+}
+
+// cassette records GraphQL traffic to a file (LINEAR_RECORD) or serves it
+// back without network access (LINEAR_REPLAY)
+type cassette struct {
+	path   string
+	replay bool
+
+	mu           sync.Mutex
+	interactions []cassetteInteraction
+	// served counts replayed responses per key so repeated identical
+	// requests (e.g. polling) are answered in recorded order
+	served map[string]int
+}
+
+var (
+	cassetteOnce   sync.Once
+	activeCassette *cassette
+	cassetteErr    error
+)
+
+// loadCassette reads LINEAR_REPLAY or LINEAR_RECORD once per process
+func loadCassette() (*cassette, error) {
+	cassetteOnce.Do(func() {
+		activeCassette, cassetteErr = cassetteFromEnv()
+	})
+	return activeCassette, cassetteErr
+}
+
+func cassetteFromEnv() (*cassette, error) {
+	replayPath := os.Getenv("LINEAR_REPLAY")
+	recordPath := os.Getenv("LINEAR_RECORD")
+
+	switch {
+	case replayPath != "" && recordPath != "":
+		return nil, fmt.Errorf("LINEAR_RECORD and LINEAR_REPLAY cannot be used together")
+	case replayPath != "":
+		data, err := os.ReadFile(replayPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		var file cassetteFile
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %w", replayPath, err)
+		}
+		return &cassette{
+			path:         replayPath,
+			replay:       true,
+			interactions: file.Interactions,
+			served:       map[string]int{},
+		}, nil
+	case recordPath != "":
+		c := &cassette{path: recordPath}
+		// Append to an existing cassette so a session can be recorded
+		// across several commands
+		if data, err := os.ReadFile(recordPath); err == nil {
+			var file cassetteFile
+			if err := json.Unmarshal(data, &file); err != nil {
+				return nil, fmt.Errorf("invalid cassette %s: %w", recordPath, err)
+			}
+			c.interactions = file.Interactions
+		}
+		return c, nil
+	}
+
+	return nil, nil
+}
+
+// Replaying reports whether requests are served from a cassette, in which
+// case no credentials are needed
+func Replaying() bool {
+	c, _ := loadCassette()
+	return c != nil && c.replay
+}
+
+// timestampPattern matches ISO 8601 timestamps such as the readAt,
+// watermark and report window values commands derive from the clock
+var timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}(?::\d{2}(?:\.\d+)?)?(?:Z|[+-]\d{2}:?\d{2})?`)
+
+// cassetteKey normalizes a GraphQL request body into its lookup key: the
+// query with whitespace collapsed plus canonically encoded variables, with
+// timestamps replaced so requests built from time.Now() still match on
+// replay. The returned query and variables are the originals.
+func cassetteKey(body []byte) (key, query string, variables json.RawMessage, err error) {
+	var payload struct {
+		Query     string      `json:"query"`
+		Variables interface{} `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", "", nil, fmt.Errorf("cassette: request body is not GraphQL JSON: %w", err)
+	}
+
+	query = strings.Join(strings.Fields(payload.Query), " ")
+
+	// encoding/json sorts map keys, so re-encoding makes variable order
+	// irrelevant
+	if payload.Variables != nil {
+		if variables, err = json.Marshal(payload.Variables); err != nil {
+			return "", "", nil, err
+		}
+		if string(variables) == "{}" {
+			variables = nil
+		}
+	}
+
+	keyed := timestampPattern.ReplaceAllString(query+"\n"+string(variables), "<timestamp>")
+	sum := sha256.Sum256([]byte(keyed))
+	return hex.EncodeToString(sum[:12]), query, variables, nil
+}
+
+// transport wraps base with recording, or replaces it in replay mode
+func (c *cassette) transport(base http.RoundTripper) http.RoundTripper {
+	return &cassetteTransport{cassette: c, base: base}
+}
+
+type cassetteTransport struct {
+	cassette *cassette
+	base     http.RoundTripper
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	key, query, variables, err := cassetteKey(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.cassette.replay {
+		return t.cassette.play(req, key, body)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// The key is taken from the original request, so masking what is saved
+	// doesn't affect replay
+	operation, _ := describeOperation(body)
+	saved := []byte(redactCassette(string(respBody)))
+	interaction := cassetteInteraction{
+		Key:       key,
+		Operation: operation,
+		Query:     redactCassette(query),
+		Status:    resp.StatusCode,
+		Response:  json.RawMessage(saved),
+	}
+	if len(variables) > 0 {
+		interaction.Variables = json.RawMessage(redactCassette(string(variables)))
+	}
+	if !json.Valid(saved) {
+		// Keep non-JSON error pages replayable as a JSON string
+		quoted, _ := json.Marshal(string(saved))
+		interaction.Response = quoted
+	}
+
+	if err := t.cassette.add(interaction); err != nil {
+		return nil, fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return resp, nil
+}
+
+// secretFieldPattern matches webhook signing secrets in GraphQL input
+// (`secret: "..."`) and JSON responses (`"secret":"..."`)
+var secretFieldPattern = regexp.MustCompile(`("?secret"?\s*:\s*")(?:[^"\\]|\\.)*`)
+
+// redactCassette masks tokens, registered secrets and webhook signing
+// secrets before an interaction is written to disk. Cassettes are meant to
+// be committed as fixtures, so secrets the user is shown on screen, such
+// as a new webhook's signing secret, are masked here as well.
+func redactCassette(s string) string {
+	return secretFieldPattern.ReplaceAllString(output.Redact(s), "${1}"+output.RedactedMask)
+}
+
+// add appends an interaction and rewrites the cassette file
+func (c *cassette) add(interaction cassetteInteraction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, interaction)
+
+	data, err := json.MarshalIndent(cassetteFile{
+		Version:      cassetteVersion,
+		Interactions: c.interactions,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0600)
+}
+
+// play serves the next recorded response for key
+func (c *cassette) play(req *http.Request, key string, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []cassetteInteraction
+	for _, i := range c.interactions {
+		if i.Key == key {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		operation, _ := describeOperation(body)
+		return nil, fmt.Errorf("%w: %s (key %s) not found in %s; re-record with LINEAR_RECORD=%s",
+			ErrCassetteMiss, operation, key, c.path, c.path)
+	}
+
+	// Serve matches in recorded order, then keep repeating the last one
+	n := c.served[key]
+	if n >= len(matches) {
+		n = len(matches) - 1
+	}
+	c.served[key]++
+	interaction := matches[n]
+
+	respBody := []byte(interaction.Response)
+	var text string
+	if json.Unmarshal(respBody, &text) == nil {
+		respBody = []byte(text)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+		StatusCode:    interaction.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
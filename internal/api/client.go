@@ -1,64 +1,199 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hasura/go-graphql-client"
 	"github.com/juanbermudez/agent-linear-cli/internal/auth"
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
 )
 
 const (
 	// LinearAPIEndpoint is the Linear GraphQL API endpoint
 	LinearAPIEndpoint = "https://api.linear.app/graphql"
+
+	// RequestTimeout bounds a single API request so a hung connection
+	// cannot block a command forever
+	RequestTimeout = 60 * time.Second
 )
 
 // Client is the Linear API client
 type Client struct {
 	graphql    *graphql.Client
 	httpClient *http.Client
+	transport  *authTransport
+	method     auth.AuthMethod
+	actor      *AppActor
+}
+
+// AppActor is the display identity used when an OAuth app creates issues,
+// comments and project updates as itself
+type AppActor struct {
+	Name    string `json:"name,omitempty"`
+	IconURL string `json:"iconUrl,omitempty"`
 }
 
+// ErrReadOnlyToken is returned when a mutation is attempted with a token
+// whose scopes only allow reading
+var ErrReadOnlyToken = errors.New("token is read-only (scope: read); re-authenticate with write access, e.g. 'linear auth login --web --scopes read,write'")
+
 // NewClient creates a new Linear API client using the auth manager
 func NewClient(ctx context.Context) (*Client, error) {
+	if _, err := loadCassette(); err != nil {
+		return nil, err
+	}
+
+	// Replayed sessions never reach Linear, so no credentials are needed
+	if Replaying() {
+		return NewClientWithToken(""), nil
+	}
+
 	manager := auth.NewManager()
-	token, _, err := manager.GetToken(ctx)
+	token, method, err := manager.GetToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewClientWithToken(token), nil
+	// Keep the token out of any output even if it lacks a lin_ prefix
+	output.RegisterSecret(token)
+
+	client := NewClientWithToken(token)
+	client.method = method
+
+	// OAuth tokens carry their scopes; refuse mutations up front when they
+	// cannot succeed
+	if method != auth.AuthMethodAPIKey {
+		if info, err := manager.StoredTokenInfo(); err == nil && info != nil && info.AccessToken == token {
+			client.transport.readOnly = auth.ReadOnlyScopes(info.Scopes())
+		}
+	}
+
+	return client, nil
 }
 
 // NewClientWithToken creates a new Linear API client with a specific token
 func NewClientWithToken(token string) *Client {
+	var base http.RoundTripper = http.DefaultTransport
+	if t, err := config.HTTPTransport(); err != nil {
+		base = failingTransport{err: err}
+	} else {
+		base = t
+	}
+
+	if c, err := loadCassette(); err != nil {
+		base = failingTransport{err: err}
+	} else if c != nil {
+		base = c.transport(base)
+	}
+
+	transport := &authTransport{
+		token:  token,
+		base:   base,
+		tracer: activeTracer,
+	}
 	httpClient := &http.Client{
-		Transport: &authTransport{
-			token: token,
-			base:  http.DefaultTransport,
-		},
+		Transport: transport,
+		Timeout:   RequestTimeout,
 	}
 
 	return &Client{
 		graphql:    graphql.NewClient(LinearAPIEndpoint, httpClient),
 		httpClient: httpClient,
+		transport:  transport,
 	}
 }
 
 // authTransport adds the Authorization header to all requests
 type authTransport struct {
-	token string
-	base  http.RoundTripper
+	token    string
+	readOnly bool
+	base     http.RoundTripper
+	tracer   *tracer
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.readOnly && req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if isMutation(body) {
+			return nil, ErrReadOnlyToken
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
 	req.Header.Set("Authorization", t.token)
 	req.Header.Set("Content-Type", "application/json")
+	if t.tracer != nil {
+		return t.tracer.roundTrip(t.base, req)
+	}
 	return t.base.RoundTrip(req)
 }
 
+// failingTransport fails every request, so a broken network or cassette
+// setup never silently falls back to defaults
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+// SetAppActor makes CreateIssue, CreateComment and CreateProjectUpdate post
+// with a custom display name and avatar. Linear only supports this for apps
+// authenticated with client credentials.
+func (c *Client) SetAppActor(actor AppActor) error {
+	if c.method != auth.AuthMethodClientCredentials {
+		method := c.method
+		if method == "" {
+			method = auth.AuthMethodNone
+		}
+		return fmt.Errorf("posting as an app user requires client credentials authentication (current method: %s)", method)
+	}
+	c.actor = &actor
+	return nil
+}
+
+// appActorInputParts returns the createAsUser/displayIconUrl input fields
+func (c *Client) appActorInputParts() []string {
+	if c.actor == nil {
+		return nil
+	}
+	parts := []string{}
+	if c.actor.Name != "" {
+		parts = append(parts, fmt.Sprintf(`createAsUser: %q`, c.actor.Name))
+	}
+	if c.actor.IconURL != "" {
+		parts = append(parts, fmt.Sprintf(`displayIconUrl: %q`, c.actor.IconURL))
+	}
+	return parts
+}
+
+// isMutation reports whether a GraphQL request body is a mutation
+func isMutation(body []byte) bool {
+	var payload struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+	return strings.HasPrefix(strings.TrimSpace(payload.Query), "mutation")
+}
+
 // Query executes a GraphQL query
 func (c *Client) Query(ctx context.Context, q interface{}, variables map[string]interface{}) error {
 	return c.graphql.Query(ctx, q, variables)
@@ -191,7 +326,11 @@ type Document struct {
 	URL       string `json:"url"`
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
-	Creator   *struct {
+	// ArchivedAt and Trashed are set for deleted documents, which Linear
+	// still returns by ID
+	ArchivedAt string `json:"archivedAt,omitempty"`
+	Trashed    bool   `json:"trashed,omitempty"`
+	Creator    *struct {
 		ID          string `json:"id"`
 		DisplayName string `json:"displayName"`
 	} `json:"creator,omitempty"`
@@ -201,6 +340,11 @@ type Document struct {
 	} `json:"project,omitempty"`
 }
 
+// Deleted reports whether the document is archived or in the trash
+func (d *Document) Deleted() bool {
+	return d.ArchivedAt != "" || d.Trashed
+}
+
 // Team represents a Linear team
 type Team struct {
 	ID   string `json:"id"`
@@ -394,16 +538,18 @@ type IssueCreateInput struct {
 type IssueUpdateInput struct {
 	Title              string   `json:"title,omitempty"`
 	Description        string   `json:"description,omitempty"`
-	AssigneeID         string   `json:"assigneeId,omitempty"`
+	AssigneeID         Nullable `json:"assigneeId,omitzero"`
 	Priority           *int     `json:"priority,omitempty"`
 	Estimate           *float64 `json:"estimate,omitempty"`
-	DueDate            string   `json:"dueDate,omitempty"`
+	DueDate            Nullable `json:"dueDate,omitzero"`
 	LabelIDs           []string `json:"labelIds,omitempty"`
-	ProjectID          string   `json:"projectId,omitempty"`
+	ProjectID          Nullable `json:"projectId,omitzero"`
 	StateID            string   `json:"stateId,omitempty"`
-	ParentID           string   `json:"parentId,omitempty"`
-	CycleID            string   `json:"cycleId,omitempty"`
-	ProjectMilestoneID string   `json:"projectMilestoneId,omitempty"`
+	ParentID           Nullable `json:"parentId,omitzero"`
+	CycleID            Nullable `json:"cycleId,omitzero"`
+	ProjectMilestoneID Nullable `json:"projectMilestoneId,omitzero"`
+	AddedLabelIDs      []string `json:"addedLabelIds,omitempty"`
+	RemovedLabelIDs    []string `json:"removedLabelIds,omitempty"`
 }
 
 // IssueCreateResponse is the response for creating an issue
@@ -503,35 +649,69 @@ type UsersResponse struct {
 	Count int    `json:"count"`
 }
 
-// GetUsers fetches all users in the workspace
+// GetUsers fetches all users in the workspace, following pages until all
+// are loaded
 func (c *Client) GetUsers(ctx context.Context) (*UsersResponse, error) {
-	var query struct {
-		Users struct {
-			Nodes []struct {
-				ID          string `graphql:"id"`
-				Name        string `graphql:"name"`
-				DisplayName string `graphql:"displayName"`
-				Email       string `graphql:"email"`
-				Active      bool   `graphql:"active"`
-				Admin       bool   `graphql:"admin"`
-			} `graphql:"nodes"`
-		} `graphql:"users"`
-	}
+	users := []User{}
+	after := ""
+	for {
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+		queryStr := fmt.Sprintf(`query {
+		users(first: 250%s) {
+			nodes {
+				id
+				name
+				displayName
+				email
+				active
+				admin
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}`, afterArg)
 
-	if err := c.Query(ctx, &query, nil); err != nil {
-		return nil, err
-	}
+		var result struct {
+			Users struct {
+				Nodes []struct {
+					ID          string `json:"id"`
+					Name        string `json:"name"`
+					DisplayName string `json:"displayName"`
+					Email       string `json:"email"`
+					Active      bool   `json:"active"`
+					Admin       bool   `json:"admin"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"users"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
 
-	users := make([]User, len(query.Users.Nodes))
-	for i, u := range query.Users.Nodes {
-		users[i] = User{
-			ID:          u.ID,
-			Name:        u.Name,
-			DisplayName: u.DisplayName,
-			Email:       u.Email,
-			Active:      u.Active,
-			Admin:       u.Admin,
+		for _, u := range result.Users.Nodes {
+			users = append(users, User{
+				ID:          u.ID,
+				Name:        u.Name,
+				DisplayName: u.DisplayName,
+				Email:       u.Email,
+				Active:      u.Active,
+				Admin:       u.Admin,
+			})
+		}
+
+		if !result.Users.PageInfo.HasNextPage {
+			break
 		}
+		after = result.Users.PageInfo.EndCursor
 	}
 
 	return &UsersResponse{
@@ -637,6 +817,100 @@ func (c *Client) GetLabels(ctx context.Context, teamID string) (*LabelsResponse,
 	}, nil
 }
 
+// AvailableLabel is a label that can be applied to a team's issues, either
+// a team label or a workspace label
+type AvailableLabel struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Color      string `json:"color"`
+	IsGroup    bool   `json:"isGroup"`
+	ParentID   string `json:"parentId,omitempty"`
+	ParentName string `json:"parentName,omitempty"`
+	TeamID     string `json:"teamId,omitempty"`
+}
+
+// GetAvailableLabels fetches the team's labels plus workspace labels, with
+// their label groups, following pages until all are loaded
+func (c *Client) GetAvailableLabels(ctx context.Context, teamID string) ([]AvailableLabel, error) {
+	var labels []AvailableLabel
+	after := ""
+	for {
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+		queryStr := fmt.Sprintf(`query {
+		issueLabels(first: 250%s, filter: { or: [{ team: { id: { eq: %q } } }, { team: { null: true } }] }) {
+			nodes {
+				id
+				name
+				color
+				isGroup
+				parent {
+					id
+					name
+				}
+				team {
+					id
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}`, afterArg, teamID)
+
+		var result struct {
+			IssueLabels struct {
+				Nodes []struct {
+					ID      string `json:"id"`
+					Name    string `json:"name"`
+					Color   string `json:"color"`
+					IsGroup bool   `json:"isGroup"`
+					Parent  *struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+					} `json:"parent"`
+					Team *struct {
+						ID string `json:"id"`
+					} `json:"team"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"issueLabels"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		for _, n := range result.IssueLabels.Nodes {
+			label := AvailableLabel{
+				ID:      n.ID,
+				Name:    n.Name,
+				Color:   n.Color,
+				IsGroup: n.IsGroup,
+			}
+			if n.Parent != nil {
+				label.ParentID = n.Parent.ID
+				label.ParentName = n.Parent.Name
+			}
+			if n.Team != nil {
+				label.TeamID = n.Team.ID
+			}
+			labels = append(labels, label)
+		}
+
+		if !result.IssueLabels.PageInfo.HasNextPage {
+			return labels, nil
+		}
+		after = result.IssueLabels.PageInfo.EndCursor
+	}
+}
+
 // IssueFilter contains filters for listing issues
 type IssueFilter struct {
 	TeamID     string
@@ -1062,6 +1336,295 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, limit int
 	return comments, nil
 }
 
+// IssueHistoryChange represents a single field change within a history entry
+type IssueHistoryChange struct {
+	Field   string   `json:"field"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// IssueHistoryEntry represents one change event in an issue's history
+type IssueHistoryEntry struct {
+	ID        string               `json:"id"`
+	CreatedAt string               `json:"createdAt"`
+	Actor     *IssueAssignee       `json:"actor,omitempty"`
+	BotActor  string               `json:"botActor,omitempty"`
+	Changes   []IssueHistoryChange `json:"changes"`
+}
+
+// IssueHistoryResponse is the response for issue history
+type IssueHistoryResponse struct {
+	IssueID    string              `json:"issueId"`
+	Identifier string              `json:"identifier"`
+	History    []IssueHistoryEntry `json:"history"`
+	Count      int                 `json:"count"`
+	HasMore    bool                `json:"hasMore,omitempty"`
+}
+
+// GetIssueHistory fetches the audit trail of an issue in chronological order.
+// Entries that don't touch a tracked field (state, assignee, priority, labels,
+// estimate, project, cycle) are skipped. With a non-zero since, pages are
+// fetched until entries are older than since; limit caps the entries fetched
+// either way, and HasMore reports that it cut the trail short.
+func (c *Client) GetIssueHistory(ctx context.Context, issueID string, since time.Time, limit int) (*IssueHistoryResponse, error) {
+	type namedRef struct {
+		Name string `json:"name"`
+	}
+	type userRef struct {
+		DisplayName string `json:"displayName"`
+	}
+	type cycleRef struct {
+		Number float64 `json:"number"`
+		Name   string  `json:"name"`
+	}
+	type historyNode struct {
+		ID        string `json:"id"`
+		CreatedAt string `json:"createdAt"`
+		Actor     *struct {
+			ID          string `json:"id"`
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+		} `json:"actor"`
+		BotActor      *namedRef  `json:"botActor"`
+		FromState     *namedRef  `json:"fromState"`
+		ToState       *namedRef  `json:"toState"`
+		FromAssignee  *userRef   `json:"fromAssignee"`
+		ToAssignee    *userRef   `json:"toAssignee"`
+		FromPriority  *float64   `json:"fromPriority"`
+		ToPriority    *float64   `json:"toPriority"`
+		FromEstimate  *float64   `json:"fromEstimate"`
+		ToEstimate    *float64   `json:"toEstimate"`
+		FromProject   *namedRef  `json:"fromProject"`
+		ToProject     *namedRef  `json:"toProject"`
+		FromCycle     *cycleRef  `json:"fromCycle"`
+		ToCycle       *cycleRef  `json:"toCycle"`
+		AddedLabels   []namedRef `json:"addedLabels"`
+		RemovedLabels []namedRef `json:"removedLabels"`
+	}
+
+	var (
+		issueRef struct {
+			ID         string
+			Identifier string
+		}
+		nodes   []historyNode
+		after   string
+		hasMore bool
+	)
+
+	// Linear returns newest first, so paging stops at the first entry
+	// older than since
+	for {
+		page := min(limit-len(nodes), 100)
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+
+		queryStr := fmt.Sprintf(`query {
+		issue(id: %q) {
+			id
+			identifier
+			history(first: %d%s) {
+				nodes {
+					id
+					createdAt
+					actor {
+						id
+						name
+						displayName
+					}
+					botActor {
+						name
+					}
+					fromState {
+						name
+					}
+					toState {
+						name
+					}
+					fromAssignee {
+						displayName
+					}
+					toAssignee {
+						displayName
+					}
+					fromPriority
+					toPriority
+					fromEstimate
+					toEstimate
+					fromProject {
+						name
+					}
+					toProject {
+						name
+					}
+					fromCycle {
+						number
+						name
+					}
+					toCycle {
+						number
+						name
+					}
+					addedLabels {
+						name
+					}
+					removedLabels {
+						name
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`, issueID, page, afterArg)
+
+		var result struct {
+			Issue *struct {
+				ID         string `json:"id"`
+				Identifier string `json:"identifier"`
+				History    struct {
+					Nodes    []historyNode `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"history"`
+			} `json:"issue"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		if result.Issue == nil {
+			return nil, fmt.Errorf("issue '%s' not found", issueID)
+		}
+		issueRef.ID = result.Issue.ID
+		issueRef.Identifier = result.Issue.Identifier
+
+		reachedSince := false
+		for _, h := range result.Issue.History.Nodes {
+			if !since.IsZero() {
+				if createdAt, err := time.Parse(time.RFC3339, h.CreatedAt); err == nil && createdAt.Before(since) {
+					reachedSince = true
+					continue
+				}
+			}
+			nodes = append(nodes, h)
+		}
+
+		pageInfo := result.Issue.History.PageInfo
+		if reachedSince || !pageInfo.HasNextPage {
+			break
+		}
+		if len(nodes) >= limit {
+			hasMore = true
+			break
+		}
+		after = pageInfo.EndCursor
+	}
+
+	nameOf := func(r *namedRef) string {
+		if r == nil {
+			return ""
+		}
+		return r.Name
+	}
+	userOf := func(u *userRef) string {
+		if u == nil {
+			return ""
+		}
+		return u.DisplayName
+	}
+	cycleOf := func(c *cycleRef) string {
+		if c == nil {
+			return ""
+		}
+		if c.Name != "" {
+			return c.Name
+		}
+		return fmt.Sprintf("Cycle %g", c.Number)
+	}
+	numberOf := func(f *float64) string {
+		if f == nil {
+			return ""
+		}
+		return fmt.Sprintf("%g", *f)
+	}
+
+	entries := make([]IssueHistoryEntry, 0, len(nodes))
+	for _, h := range nodes {
+		changes := []IssueHistoryChange{}
+
+		if h.FromState != nil || h.ToState != nil {
+			changes = append(changes, IssueHistoryChange{Field: "state", From: nameOf(h.FromState), To: nameOf(h.ToState)})
+		}
+		if h.FromAssignee != nil || h.ToAssignee != nil {
+			changes = append(changes, IssueHistoryChange{Field: "assignee", From: userOf(h.FromAssignee), To: userOf(h.ToAssignee)})
+		}
+		if h.FromPriority != nil || h.ToPriority != nil {
+			changes = append(changes, IssueHistoryChange{Field: "priority", From: numberOf(h.FromPriority), To: numberOf(h.ToPriority)})
+		}
+		if h.FromEstimate != nil || h.ToEstimate != nil {
+			changes = append(changes, IssueHistoryChange{Field: "estimate", From: numberOf(h.FromEstimate), To: numberOf(h.ToEstimate)})
+		}
+		if h.FromProject != nil || h.ToProject != nil {
+			changes = append(changes, IssueHistoryChange{Field: "project", From: nameOf(h.FromProject), To: nameOf(h.ToProject)})
+		}
+		if h.FromCycle != nil || h.ToCycle != nil {
+			changes = append(changes, IssueHistoryChange{Field: "cycle", From: cycleOf(h.FromCycle), To: cycleOf(h.ToCycle)})
+		}
+		if len(h.AddedLabels) > 0 || len(h.RemovedLabels) > 0 {
+			change := IssueHistoryChange{Field: "labels"}
+			for _, l := range h.AddedLabels {
+				change.Added = append(change.Added, l.Name)
+			}
+			for _, l := range h.RemovedLabels {
+				change.Removed = append(change.Removed, l.Name)
+			}
+			changes = append(changes, change)
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		entry := IssueHistoryEntry{
+			ID:        h.ID,
+			CreatedAt: h.CreatedAt,
+			BotActor:  nameOf(h.BotActor),
+			Changes:   changes,
+		}
+		if h.Actor != nil {
+			entry.Actor = &IssueAssignee{
+				ID:          h.Actor.ID,
+				Name:        h.Actor.Name,
+				DisplayName: h.Actor.DisplayName,
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	// Linear returns newest first; present the trail chronologically
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt < entries[j].CreatedAt
+	})
+
+	return &IssueHistoryResponse{
+		IssueID:    issueRef.ID,
+		Identifier: issueRef.Identifier,
+		History:    entries,
+		Count:      len(entries),
+		HasMore:    hasMore,
+	}, nil
+}
+
 // CreateIssue creates a new issue
 func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*IssueCreateResponse, error) {
 	// Build input fields for the mutation
@@ -1110,6 +1673,7 @@ func (c *Client) CreateIssue(ctx context.Context, input IssueCreateInput) (*Issu
 	if input.ProjectMilestoneID != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`projectMilestoneId: %q`, input.ProjectMilestoneID))
 	}
+	inputParts = append(inputParts, c.appActorInputParts()...)
 
 	// Build input string
 	inputStr := ""
@@ -1176,18 +1740,14 @@ func (c *Client) UpdateIssue(ctx context.Context, issueID string, input IssueUpd
 	if input.Description != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`description: %q`, input.Description))
 	}
-	if input.AssigneeID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`assigneeId: %q`, input.AssigneeID))
-	}
+	inputParts = appendNullable(inputParts, "assigneeId", input.AssigneeID)
 	if input.Priority != nil {
 		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
 	}
 	if input.Estimate != nil {
 		inputParts = append(inputParts, fmt.Sprintf(`estimate: %v`, *input.Estimate))
 	}
-	if input.DueDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`dueDate: %q`, input.DueDate))
-	}
+	inputParts = appendNullable(inputParts, "dueDate", input.DueDate)
 	if len(input.LabelIDs) > 0 {
 		labels := ""
 		for i, id := range input.LabelIDs {
@@ -1198,20 +1758,18 @@ func (c *Client) UpdateIssue(ctx context.Context, issueID string, input IssueUpd
 		}
 		inputParts = append(inputParts, fmt.Sprintf(`labelIds: [%s]`, labels))
 	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
+	inputParts = appendNullable(inputParts, "projectId", input.ProjectID)
 	if input.StateID != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`stateId: %q`, input.StateID))
 	}
-	if input.ParentID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`parentId: %q`, input.ParentID))
-	}
-	if input.CycleID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`cycleId: %q`, input.CycleID))
+	inputParts = appendNullable(inputParts, "parentId", input.ParentID)
+	inputParts = appendNullable(inputParts, "cycleId", input.CycleID)
+	inputParts = appendNullable(inputParts, "projectMilestoneId", input.ProjectMilestoneID)
+	if len(input.AddedLabelIDs) > 0 {
+		inputParts = append(inputParts, fmt.Sprintf(`addedLabelIds: %s`, graphqlStringList(input.AddedLabelIDs)))
 	}
-	if input.ProjectMilestoneID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectMilestoneId: %q`, input.ProjectMilestoneID))
+	if len(input.RemovedLabelIDs) > 0 {
+		inputParts = append(inputParts, fmt.Sprintf(`removedLabelIds: %s`, graphqlStringList(input.RemovedLabelIDs)))
 	}
 
 	if len(inputParts) == 0 {
@@ -1413,8 +1971,14 @@ func (c *Client) SearchIssues(ctx context.Context, term string, limit int, inclu
 
 // CreateComment creates a comment on an issue
 func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
+	inputParts := []string{
+		fmt.Sprintf(`issueId: %q`, issueID),
+		fmt.Sprintf(`body: %q`, body),
+	}
+	inputParts = append(inputParts, c.appActorInputParts()...)
+
 	mutationStr := fmt.Sprintf(`mutation {
-		commentCreate(input: { issueId: %q, body: %q }) {
+		commentCreate(input: { %s }) {
 			success
 			comment {
 				id
@@ -1427,7 +1991,7 @@ func (c *Client) CreateComment(ctx context.Context, issueID string, body string)
 				}
 			}
 		}
-	}`, issueID, body)
+	}`, strings.Join(inputParts, ", "))
 
 	var result struct {
 		CommentCreate struct {
@@ -1689,6 +2253,9 @@ type ProjectDetail struct {
 		Key  string `json:"key"`
 		Name string `json:"name"`
 	} `json:"teams,omitempty"`
+	Labels    []ProjectLabel            `json:"labels,omitempty"`
+	Members   []ProjectMember           `json:"members,omitempty"`
+	Relations *ProjectRelationsResponse `json:"relations,omitempty"`
 }
 
 // ProjectListItem represents a project in a list
@@ -1699,6 +2266,7 @@ type ProjectListItem struct {
 	State      string  `json:"state"`
 	Progress   float64 `json:"progress"`
 	TargetDate string  `json:"targetDate,omitempty"`
+	Health     string  `json:"health,omitempty"`
 	URL        string  `json:"url"`
 	UpdatedAt  string  `json:"updatedAt"`
 	Status     *struct {
@@ -1713,17 +2281,85 @@ type ProjectListItem struct {
 	Teams []struct {
 		Key string `json:"key"`
 	} `json:"teams,omitempty"`
+	Labels []ProjectLabel `json:"labels,omitempty"`
 }
 
-// ProjectsResponse is the response for listing projects
-type ProjectsResponse struct {
-	Projects []ProjectListItem `json:"projects"`
-	Count    int               `json:"count"`
+// ProjectLabel is a label applied to a project
+type ProjectLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
 }
 
-// SearchProjectsResponse is the response for searching projects
-type SearchProjectsResponse struct {
-	Projects   []ProjectListItem `json:"projects"`
+// ProjectFilter contains filters for listing projects. It is compiled to
+// Linear's ProjectFilter input.
+type ProjectFilter struct {
+	TeamID       string
+	StatusTypes  []string // backlog, planned, started, paused, completed, canceled
+	LeadID       string
+	Health       []string // onTrack, atRisk, offTrack
+	InitiativeID string
+	LabelIDs     []string // matches projects with any of the labels
+	TargetAfter  string   // YYYY-MM-DD, inclusive
+	TargetBefore string   // YYYY-MM-DD, inclusive
+	MemberID     string
+}
+
+// filterArg renders the filter as a GraphQL argument, or "" when empty
+func (f ProjectFilter) filterArg() string {
+	filterParts := []string{}
+
+	// accessibleTeams, as in GetProjectChanges, matches projects the team
+	// belongs to
+	if f.TeamID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`accessibleTeams: { id: { eq: %q } }`, f.TeamID))
+	}
+	if len(f.StatusTypes) > 0 {
+		filterParts = append(filterParts, fmt.Sprintf(`status: { type: { in: %s } }`, graphqlStringList(f.StatusTypes)))
+	}
+	if f.LeadID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`lead: { id: { eq: %q } }`, f.LeadID))
+	}
+	if len(f.Health) > 0 {
+		filterParts = append(filterParts, fmt.Sprintf(`health: { in: %s }`, graphqlStringList(f.Health)))
+	}
+	if f.InitiativeID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`initiatives: { some: { id: { eq: %q } } }`, f.InitiativeID))
+	}
+	if len(f.LabelIDs) > 0 {
+		filterParts = append(filterParts, fmt.Sprintf(`labels: { some: { id: { in: %s } } }`, graphqlStringList(f.LabelIDs)))
+	}
+
+	dateParts := []string{}
+	if f.TargetAfter != "" {
+		dateParts = append(dateParts, fmt.Sprintf(`gte: %q`, f.TargetAfter))
+	}
+	if f.TargetBefore != "" {
+		dateParts = append(dateParts, fmt.Sprintf(`lte: %q`, f.TargetBefore))
+	}
+	if len(dateParts) > 0 {
+		filterParts = append(filterParts, fmt.Sprintf(`targetDate: { %s }`, strings.Join(dateParts, ", ")))
+	}
+
+	if f.MemberID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`members: { some: { id: { eq: %q } } }`, f.MemberID))
+	}
+
+	if len(filterParts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`, filter: { %s }`, strings.Join(filterParts, ", "))
+}
+
+// ProjectsResponse is the response for listing projects
+type ProjectsResponse struct {
+	Projects []ProjectListItem `json:"projects"`
+	Count    int               `json:"count"`
+}
+
+// SearchProjectsResponse is the response for searching projects
+type SearchProjectsResponse struct {
+	Projects   []ProjectListItem `json:"projects"`
 	TotalCount int               `json:"totalCount"`
 	HasMore    bool              `json:"hasMore"`
 	Query      string            `json:"query"`
@@ -1742,31 +2378,46 @@ type ProjectCreateInput struct {
 	StartDate   string   `json:"startDate,omitempty"`
 	TargetDate  string   `json:"targetDate,omitempty"`
 	Priority    *int     `json:"priority,omitempty"`
+	LabelIDs    []string `json:"labelIds,omitempty"`
+	MemberIDs   []string `json:"memberIds,omitempty"`
 }
 
 // ProjectUpdateInput is the input for updating a project
 type ProjectUpdateInput struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	StatusID    string `json:"statusId,omitempty"`
-	LeadID      string `json:"leadId,omitempty"`
-	Icon        string `json:"icon,omitempty"`
-	Color       string `json:"color,omitempty"`
-	StartDate   string `json:"startDate,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
-	Priority    *int   `json:"priority,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	StatusID    string   `json:"statusId,omitempty"`
+	LeadID      Nullable `json:"leadId,omitzero"`
+	Icon        Nullable `json:"icon,omitzero"`
+	Color       Nullable `json:"color,omitzero"`
+	StartDate   Nullable `json:"startDate,omitzero"`
+	TargetDate  Nullable `json:"targetDate,omitzero"`
+	Priority    *int     `json:"priority,omitempty"`
 }
 
-// GetProjects fetches projects
-func (c *Client) GetProjects(ctx context.Context, teamID string, limit int) (*ProjectsResponse, error) {
-	filterPart := ""
-	if teamID != "" {
-		filterPart = fmt.Sprintf(`, filter: { teams: { id: { eq: "%s" } } }`, teamID)
-	}
+// GetProjects fetches projects matching the filter. sortBy is one of
+// "updated" (most recently updated first, the default), "target-date"
+// (soonest first, undated last) or "progress" (least complete first).
+// The API can only order by update time, so the latter two load every
+// matching project and sort client-side before applying limit.
+func (c *Client) GetProjects(ctx context.Context, filter ProjectFilter, limit int, sortBy string) (*ProjectsResponse, error) {
+	clientSorted := sortBy == "target-date" || sortBy == "progress"
+
+	projects := []ProjectListItem{}
+	after := ""
+	for clientSorted || len(projects) < limit {
+		page := 250
+		if !clientSorted {
+			page = min(limit-len(projects), 250)
+		}
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
 
-	queryStr := fmt.Sprintf(`query {
-		projects(first: %d%s) {
+		queryStr := fmt.Sprintf(`query {
+		projects(first: %d%s, orderBy: updatedAt%s) {
 			nodes {
 				id
 				name
@@ -1774,6 +2425,7 @@ func (c *Client) GetProjects(ctx context.Context, teamID string, limit int) (*Pr
 				state
 				progress
 				targetDate
+				health
 				url
 				updatedAt
 				status {
@@ -1790,66 +2442,110 @@ func (c *Client) GetProjects(ctx context.Context, teamID string, limit int) (*Pr
 						key
 					}
 				}
+				labels {
+					nodes {
+						id
+						name
+						color
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
 			}
 		}
-	}`, limit, filterPart)
+	}`, page, afterArg, filter.filterArg())
 
-	var result struct {
-		Projects struct {
-			Nodes []struct {
-				ID         string  `json:"id"`
-				Name       string  `json:"name"`
-				SlugID     string  `json:"slugId"`
-				State      string  `json:"state"`
-				Progress   float64 `json:"progress"`
-				TargetDate string  `json:"targetDate"`
-				URL        string  `json:"url"`
-				UpdatedAt  string  `json:"updatedAt"`
-				Status     *struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-					Type string `json:"type"`
-				} `json:"status"`
-				Lead *struct {
-					ID          string `json:"id"`
-					DisplayName string `json:"displayName"`
-				} `json:"lead"`
-				Teams struct {
-					Nodes []struct {
-						Key string `json:"key"`
-					} `json:"nodes"`
-				} `json:"teams"`
-			} `json:"nodes"`
-		} `json:"projects"`
-	}
+		var result struct {
+			Projects struct {
+				Nodes []struct {
+					ID         string  `json:"id"`
+					Name       string  `json:"name"`
+					SlugID     string  `json:"slugId"`
+					State      string  `json:"state"`
+					Progress   float64 `json:"progress"`
+					TargetDate string  `json:"targetDate"`
+					Health     string  `json:"health"`
+					URL        string  `json:"url"`
+					UpdatedAt  string  `json:"updatedAt"`
+					Status     *struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+						Type string `json:"type"`
+					} `json:"status"`
+					Lead *struct {
+						ID          string `json:"id"`
+						DisplayName string `json:"displayName"`
+					} `json:"lead"`
+					Teams struct {
+						Nodes []struct {
+							Key string `json:"key"`
+						} `json:"nodes"`
+					} `json:"teams"`
+					Labels struct {
+						Nodes []ProjectLabel `json:"nodes"`
+					} `json:"labels"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"projects"`
+		}
 
-	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
-		return nil, err
-	}
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
 
-	projects := make([]ProjectListItem, len(result.Projects.Nodes))
-	for i, p := range result.Projects.Nodes {
-		teams := make([]struct {
-			Key string `json:"key"`
-		}, len(p.Teams.Nodes))
-		for j, t := range p.Teams.Nodes {
-			teams[j] = struct {
+		for _, p := range result.Projects.Nodes {
+			teams := make([]struct {
 				Key string `json:"key"`
-			}{Key: t.Key}
+			}, len(p.Teams.Nodes))
+			for j, t := range p.Teams.Nodes {
+				teams[j] = struct {
+					Key string `json:"key"`
+				}{Key: t.Key}
+			}
+			projects = append(projects, ProjectListItem{
+				ID:         p.ID,
+				Name:       p.Name,
+				SlugID:     p.SlugID,
+				State:      p.State,
+				Progress:   p.Progress,
+				TargetDate: p.TargetDate,
+				Health:     p.Health,
+				URL:        p.URL,
+				UpdatedAt:  p.UpdatedAt,
+				Status:     p.Status,
+				Lead:       p.Lead,
+				Teams:      teams,
+				Labels:     p.Labels.Nodes,
+			})
 		}
-		projects[i] = ProjectListItem{
-			ID:         p.ID,
-			Name:       p.Name,
-			SlugID:     p.SlugID,
-			State:      p.State,
-			Progress:   p.Progress,
-			TargetDate: p.TargetDate,
-			URL:        p.URL,
-			UpdatedAt:  p.UpdatedAt,
-			Status:     p.Status,
-			Lead:       p.Lead,
-			Teams:      teams,
+
+		if !result.Projects.PageInfo.HasNextPage {
+			break
 		}
+		after = result.Projects.PageInfo.EndCursor
+	}
+
+	switch sortBy {
+	case "target-date":
+		sort.SliceStable(projects, func(i, j int) bool {
+			a, b := projects[i].TargetDate, projects[j].TargetDate
+			if a == "" || b == "" {
+				return a != ""
+			}
+			return a < b
+		})
+	case "progress":
+		sort.SliceStable(projects, func(i, j int) bool {
+			return projects[i].Progress < projects[j].Progress
+		})
+	}
+	if len(projects) > limit {
+		projects = projects[:limit]
 	}
 
 	return &ProjectsResponse{
@@ -1998,6 +2694,21 @@ func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectDeta
 					name
 				}
 			}
+			labels {
+				nodes {
+					id
+					name
+					color
+				}
+			}
+			members(first: 250) {
+				nodes {
+					id
+					name
+					displayName
+					email
+				}
+			}
 		}
 	}`, projectID)
 
@@ -2034,6 +2745,12 @@ func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectDeta
 					Name string `json:"name"`
 				} `json:"nodes"`
 			} `json:"teams"`
+			Labels struct {
+				Nodes []ProjectLabel `json:"nodes"`
+			} `json:"labels"`
+			Members struct {
+				Nodes []ProjectMember `json:"nodes"`
+			} `json:"members"`
 		} `json:"project"`
 	}
 
@@ -2058,6 +2775,8 @@ func (c *Client) GetProject(ctx context.Context, projectID string) (*ProjectDeta
 		UpdatedAt:   result.Project.UpdatedAt,
 		Status:      result.Project.Status,
 		Lead:        result.Project.Lead,
+		Labels:      result.Project.Labels.Nodes,
+		Members:     result.Project.Members.Nodes,
 	}
 
 	teams := make([]struct {
@@ -2122,6 +2841,12 @@ func (c *Client) CreateProject(ctx context.Context, input ProjectCreateInput) (*
 	if input.Priority != nil {
 		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
 	}
+	if len(input.LabelIDs) > 0 {
+		inputParts = append(inputParts, fmt.Sprintf(`labelIds: %s`, graphqlStringList(input.LabelIDs)))
+	}
+	if len(input.MemberIDs) > 0 {
+		inputParts = append(inputParts, fmt.Sprintf(`memberIds: %s`, graphqlStringList(input.MemberIDs)))
+	}
 
 	inputStr := ""
 	for i, part := range inputParts {
@@ -2242,21 +2967,11 @@ func (c *Client) UpdateProject(ctx context.Context, projectID string, input Proj
 	if input.StatusID != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`statusId: %q`, input.StatusID))
 	}
-	if input.LeadID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`leadId: %q`, input.LeadID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
-	if input.StartDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`startDate: %q`, input.StartDate))
-	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
-	}
+	inputParts = appendNullable(inputParts, "leadId", input.LeadID)
+	inputParts = appendNullable(inputParts, "icon", input.Icon)
+	inputParts = appendNullable(inputParts, "color", input.Color)
+	inputParts = appendNullable(inputParts, "startDate", input.StartDate)
+	inputParts = appendNullable(inputParts, "targetDate", input.TargetDate)
 	if input.Priority != nil {
 		inputParts = append(inputParts, fmt.Sprintf(`priority: %d`, *input.Priority))
 	}
@@ -2668,6 +3383,7 @@ func (c *Client) CreateProjectUpdate(ctx context.Context, projectID, body string
 	if health != nil {
 		inputParts = append(inputParts, fmt.Sprintf(`health: %s`, *health))
 	}
+	inputParts = append(inputParts, c.appActorInputParts()...)
 
 	mutationStr := fmt.Sprintf(`mutation {
 		projectUpdateCreate(input: { %s }) {
@@ -2773,11 +3489,11 @@ type DocumentCreateInput struct {
 
 // DocumentUpdateInput is the input for updating a document
 type DocumentUpdateInput struct {
-	Title     string `json:"title,omitempty"`
-	Content   string `json:"content,omitempty"`
-	ProjectID string `json:"projectId,omitempty"`
-	Icon      string `json:"icon,omitempty"`
-	Color     string `json:"color,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Content   string   `json:"content,omitempty"`
+	ProjectID Nullable `json:"projectId,omitzero"`
+	Icon      Nullable `json:"icon,omitzero"`
+	Color     Nullable `json:"color,omitzero"`
 }
 
 // GetDocuments fetches documents
@@ -2866,6 +3582,8 @@ func (c *Client) GetDocument(ctx context.Context, documentID string) (*Document,
 			url
 			createdAt
 			updatedAt
+			archivedAt
+			trashed
 			creator {
 				id
 				displayName
@@ -2878,28 +3596,13 @@ func (c *Client) GetDocument(ctx context.Context, documentID string) (*Document,
 	}`, documentID)
 
 	var result struct {
-		Document struct {
-			ID        string `json:"id"`
-			Title     string `json:"title"`
-			Content   string `json:"content"`
-			Icon      string `json:"icon"`
-			Color     string `json:"color"`
-			SlugID    string `json:"slugId"`
-			URL       string `json:"url"`
-			CreatedAt string `json:"createdAt"`
-			UpdatedAt string `json:"updatedAt"`
-			Creator   *struct {
-				ID          string `json:"id"`
-				DisplayName string `json:"displayName"`
-			} `json:"creator"`
-			Project *struct {
-				ID   string `json:"id"`
-				Name string `json:"name"`
-			} `json:"project"`
-		} `json:"document"`
+		Document Document `json:"document"`
 	}
 
 	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -2907,19 +3610,71 @@ func (c *Client) GetDocument(ctx context.Context, documentID string) (*Document,
 		return nil, nil
 	}
 
-	return &Document{
-		ID:        result.Document.ID,
-		Title:     result.Document.Title,
-		Content:   result.Document.Content,
-		Icon:      result.Document.Icon,
-		Color:     result.Document.Color,
-		SlugID:    result.Document.SlugID,
-		URL:       result.Document.URL,
-		CreatedAt: result.Document.CreatedAt,
-		UpdatedAt: result.Document.UpdatedAt,
-		Creator:   result.Document.Creator,
-		Project:   result.Document.Project,
-	}, nil
+	return &result.Document, nil
+}
+
+// isNotFoundError reports whether a GraphQL error is Linear's answer for an
+// ID that doesn't exist
+func isNotFoundError(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "entity not found")
+}
+
+// GetProjectDocuments fetches every document in a project with its
+// content, following pages until all are loaded
+func (c *Client) GetProjectDocuments(ctx context.Context, projectID string) ([]Document, error) {
+	var documents []Document
+	after := ""
+	for {
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+		queryStr := fmt.Sprintf(`query {
+		documents(first: 100%s, filter: { project: { id: { eq: %q } } }) {
+			nodes {
+				id
+				title
+				content
+				icon
+				color
+				slugId
+				url
+				createdAt
+				updatedAt
+				archivedAt
+				trashed
+				project {
+					id
+					name
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}`, afterArg, projectID)
+
+		var result struct {
+			Documents struct {
+				Nodes    []Document `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"documents"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, result.Documents.Nodes...)
+		if !result.Documents.PageInfo.HasNextPage {
+			return documents, nil
+		}
+		after = result.Documents.PageInfo.EndCursor
+	}
 }
 
 // CreateDocument creates a new document
@@ -3021,15 +3776,9 @@ func (c *Client) UpdateDocument(ctx context.Context, documentID string, input Do
 	if input.Content != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`content: %q`, input.Content))
 	}
-	if input.ProjectID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`projectId: %q`, input.ProjectID))
-	}
-	if input.Icon != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`icon: %q`, input.Icon))
-	}
-	if input.Color != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`color: %q`, input.Color))
-	}
+	inputParts = appendNullable(inputParts, "projectId", input.ProjectID)
+	inputParts = appendNullable(inputParts, "icon", input.Icon)
+	inputParts = appendNullable(inputParts, "color", input.Color)
 
 	if len(inputParts) == 0 {
 		return nil, fmt.Errorf("no fields to update")
@@ -3240,10 +3989,37 @@ type Initiative struct {
 		ID          string `json:"id"`
 		DisplayName string `json:"displayName"`
 	} `json:"owner,omitempty"`
-	Projects []struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"projects,omitempty"`
+	Projects []InitiativeProject `json:"projects,omitempty"`
+	Parent   *InitiativeRef      `json:"parent,omitempty"`
+	Children []InitiativeRef     `json:"children,omitempty"`
+	Rollup   *InitiativeRollup   `json:"rollup,omitempty"`
+}
+
+// InitiativeProject is a project linked to an initiative
+type InitiativeProject struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	State      string  `json:"state,omitempty"`
+	Progress   float64 `json:"progress"`
+	Health     string  `json:"health,omitempty"`
+	TargetDate string  `json:"targetDate,omitempty"`
+}
+
+// InitiativeRef is a reference to a parent or child initiative
+type InitiativeRef struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status,omitempty"`
+	TargetDate string `json:"targetDate,omitempty"`
+}
+
+// InitiativeRollup summarizes the progress and health of an initiative's
+// projects
+type InitiativeRollup struct {
+	ProjectCount int            `json:"projectCount"`
+	Progress     float64        `json:"progress"`
+	Health       string         `json:"health,omitempty"`
+	HealthCounts map[string]int `json:"healthCounts"`
 }
 
 // InitiativeListItem represents an initiative in a list
@@ -3280,12 +4056,12 @@ type InitiativeCreateInput struct {
 
 // InitiativeUpdateInput is the input for updating an initiative
 type InitiativeUpdateInput struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"description,omitempty"`
-	Content     string `json:"content,omitempty"`
-	Status      string `json:"status,omitempty"`
-	OwnerID     string `json:"ownerId,omitempty"`
-	TargetDate  string `json:"targetDate,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	OwnerID     Nullable `json:"ownerId,omitzero"`
+	TargetDate  Nullable `json:"targetDate,omitzero"`
 }
 
 // GetInitiatives fetches initiatives
@@ -3392,6 +4168,24 @@ func (c *Client) GetInitiative(ctx context.Context, initiativeID string) (*Initi
 				nodes {
 					id
 					name
+					state
+					progress
+					health
+					targetDate
+				}
+			}
+			parentInitiative {
+				id
+				name
+				status
+				targetDate
+			}
+			subInitiatives {
+				nodes {
+					id
+					name
+					status
+					targetDate
 				}
 			}
 		}
@@ -3413,11 +4207,12 @@ func (c *Client) GetInitiative(ctx context.Context, initiativeID string) (*Initi
 				DisplayName string `json:"displayName"`
 			} `json:"owner"`
 			Projects struct {
-				Nodes []struct {
-					ID   string `json:"id"`
-					Name string `json:"name"`
-				} `json:"nodes"`
+				Nodes []InitiativeProject `json:"nodes"`
 			} `json:"projects"`
+			ParentInitiative *InitiativeRef `json:"parentInitiative"`
+			SubInitiatives   struct {
+				Nodes []InitiativeRef `json:"nodes"`
+			} `json:"subInitiatives"`
 		} `json:"initiative"`
 	}
 
@@ -3441,6 +4236,8 @@ func (c *Client) GetInitiative(ctx context.Context, initiativeID string) (*Initi
 		UpdatedAt:   result.Initiative.UpdatedAt,
 		Owner:       result.Initiative.Owner,
 		Projects:    result.Initiative.Projects.Nodes,
+		Parent:      result.Initiative.ParentInitiative,
+		Children:    result.Initiative.SubInitiatives.Nodes,
 	}, nil
 }
 
@@ -3546,12 +4343,8 @@ func (c *Client) UpdateInitiative(ctx context.Context, initiativeID string, inpu
 	if input.Status != "" {
 		inputParts = append(inputParts, fmt.Sprintf(`status: %s`, input.Status))
 	}
-	if input.OwnerID != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`ownerId: %q`, input.OwnerID))
-	}
-	if input.TargetDate != "" {
-		inputParts = append(inputParts, fmt.Sprintf(`targetDate: %q`, input.TargetDate))
-	}
+	inputParts = appendNullable(inputParts, "ownerId", input.OwnerID)
+	inputParts = appendNullable(inputParts, "targetDate", input.TargetDate)
 
 	if len(inputParts) == 0 {
 		return nil, fmt.Errorf("at least one field must be specified to update")
@@ -3767,3 +4560,1731 @@ func (c *Client) RemoveProjectFromInitiative(ctx context.Context, initiativeID,
 
 	return nil
 }
+
+// NotificationIssue is the issue linked to a notification
+type NotificationIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+}
+
+// NotificationProject is the project linked to a notification
+type NotificationProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// NotificationDocument is the document linked to a notification
+type NotificationDocument struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// NotificationComment is the comment linked to a notification
+type NotificationComment struct {
+	ID   string `json:"id"`
+	Body string `json:"body"`
+}
+
+// Notification represents an item in the viewer's inbox
+type Notification struct {
+	ID             string               `json:"id"`
+	Type           string               `json:"type"`
+	CreatedAt      string               `json:"createdAt"`
+	ReadAt         string               `json:"readAt,omitempty"`
+	ArchivedAt     string               `json:"archivedAt,omitempty"`
+	SnoozedUntilAt string               `json:"snoozedUntilAt,omitempty"`
+	Actor          *IssueAssignee       `json:"actor,omitempty"`
+	Issue          *NotificationIssue   `json:"issue,omitempty"`
+	Comment        *NotificationComment `json:"comment,omitempty"`
+	Project        *NotificationProject `json:"project,omitempty"`
+	DocumentID     string                `json:"documentId,omitempty"`
+	Document       *NotificationDocument `json:"document,omitempty"`
+}
+
+// NotificationsResponse is the response for listing notifications
+type NotificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+	Count         int            `json:"count"`
+}
+
+// GetNotifications fetches the viewer's notifications, newest first. When
+// keep is set, pages are fetched until limit notifications pass it.
+func (c *Client) GetNotifications(ctx context.Context, limit int, includeArchived bool, keep func(Notification) bool) (*NotificationsResponse, error) {
+	notifications := []Notification{}
+	after := ""
+
+	for len(notifications) < limit {
+		page := limit - len(notifications)
+		if keep != nil {
+			page = 100
+		}
+		page = min(page, 100)
+
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+
+		queryStr := fmt.Sprintf(`query {
+		notifications(first: %d, includeArchived: %t%s) {
+			nodes {
+				id
+				type
+				createdAt
+				readAt
+				archivedAt
+				snoozedUntilAt
+				actor {
+					id
+					name
+					displayName
+				}
+				... on IssueNotification {
+					issue {
+						id
+						identifier
+						title
+						url
+					}
+					comment {
+						id
+						body
+					}
+				}
+				... on ProjectNotification {
+					project {
+						id
+						name
+						url
+					}
+				}
+				... on DocumentNotification {
+					documentId
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}`, page, includeArchived, afterArg)
+
+		var result struct {
+			Notifications struct {
+				Nodes []struct {
+					ID             string `json:"id"`
+					Type           string `json:"type"`
+					CreatedAt      string `json:"createdAt"`
+					ReadAt         string `json:"readAt"`
+					ArchivedAt     string `json:"archivedAt"`
+					SnoozedUntilAt string `json:"snoozedUntilAt"`
+					Actor          *struct {
+						ID          string `json:"id"`
+						Name        string `json:"name"`
+						DisplayName string `json:"displayName"`
+					} `json:"actor"`
+					Issue      *NotificationIssue   `json:"issue"`
+					Comment    *NotificationComment `json:"comment"`
+					Project    *NotificationProject `json:"project"`
+					DocumentID string               `json:"documentId"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"notifications"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		for _, n := range result.Notifications.Nodes {
+			notification := Notification{
+				ID:             n.ID,
+				Type:           n.Type,
+				CreatedAt:      n.CreatedAt,
+				ReadAt:         n.ReadAt,
+				ArchivedAt:     n.ArchivedAt,
+				SnoozedUntilAt: n.SnoozedUntilAt,
+				Issue:          n.Issue,
+				Comment:        n.Comment,
+				Project:        n.Project,
+				DocumentID:     n.DocumentID,
+			}
+			if n.Actor != nil {
+				notification.Actor = &IssueAssignee{
+					ID:          n.Actor.ID,
+					Name:        n.Actor.Name,
+					DisplayName: n.Actor.DisplayName,
+				}
+			}
+			if keep != nil && !keep(notification) {
+				continue
+			}
+			if len(notifications) < limit {
+				notifications = append(notifications, notification)
+			}
+		}
+
+		if !result.Notifications.PageInfo.HasNextPage {
+			break
+		}
+		after = result.Notifications.PageInfo.EndCursor
+	}
+
+	if err := c.resolveNotificationDocuments(ctx, notifications); err != nil {
+		return nil, err
+	}
+
+	return &NotificationsResponse{
+		Notifications: notifications,
+		Count:         len(notifications),
+	}, nil
+}
+
+// resolveNotificationDocuments fills in the linked document of document
+// notifications, which only carry its ID
+func (c *Client) resolveNotificationDocuments(ctx context.Context, notifications []Notification) error {
+	ids := []string{}
+	for _, n := range notifications {
+		if n.DocumentID != "" && !slices.Contains(ids, n.DocumentID) {
+			ids = append(ids, n.DocumentID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	queryStr := fmt.Sprintf(`query {
+		documents(first: %d, filter: { id: { in: %s } }) {
+			nodes {
+				id
+				title
+				url
+			}
+		}
+	}`, len(ids), graphqlStringList(ids))
+
+	var result struct {
+		Documents struct {
+			Nodes []NotificationDocument `json:"nodes"`
+		} `json:"documents"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return err
+	}
+
+	for i := range notifications {
+		for j := range result.Documents.Nodes {
+			if result.Documents.Nodes[j].ID == notifications[i].DocumentID {
+				notifications[i].Document = &result.Documents.Nodes[j]
+			}
+		}
+	}
+	return nil
+}
+
+// updateNotification applies a raw input clause to a notification
+func (c *Client) updateNotification(ctx context.Context, notificationID, input string) error {
+	mutationStr := fmt.Sprintf(`mutation {
+		notificationUpdate(id: %q, input: { %s }) {
+			success
+		}
+	}`, notificationID, input)
+
+	var result struct {
+		NotificationUpdate struct {
+			Success bool `json:"success"`
+		} `json:"notificationUpdate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.NotificationUpdate.Success {
+		return fmt.Errorf("failed to update notification")
+	}
+
+	return nil
+}
+
+// MarkNotificationRead marks a notification as read
+func (c *Client) MarkNotificationRead(ctx context.Context, notificationID string) error {
+	return c.updateNotification(ctx, notificationID, fmt.Sprintf(`readAt: %q`, time.Now().UTC().Format(time.RFC3339)))
+}
+
+// MarkNotificationUnread marks a notification as unread
+func (c *Client) MarkNotificationUnread(ctx context.Context, notificationID string) error {
+	return c.updateNotification(ctx, notificationID, `readAt: null`)
+}
+
+// SnoozeNotification hides a notification until the given time
+func (c *Client) SnoozeNotification(ctx context.Context, notificationID string, until time.Time) error {
+	return c.updateNotification(ctx, notificationID, fmt.Sprintf(`snoozedUntilAt: %q`, until.UTC().Format(time.RFC3339)))
+}
+
+// ArchiveNotification archives a notification
+func (c *Client) ArchiveNotification(ctx context.Context, notificationID string) error {
+	mutationStr := fmt.Sprintf(`mutation {
+		notificationArchive(id: %q) {
+			success
+		}
+	}`, notificationID)
+
+	var result struct {
+		NotificationArchive struct {
+			Success bool `json:"success"`
+		} `json:"notificationArchive"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.NotificationArchive.Success {
+		return fmt.Errorf("failed to archive notification")
+	}
+
+	return nil
+}
+
+// ========== Change Feeds ==========
+
+// changeFeedPageSize is the page size used when paginating change feeds
+const changeFeedPageSize = 100
+
+// ChangedIssue is an issue snapshot returned by the issue change feed
+type ChangedIssue struct {
+	ID         string         `json:"id"`
+	Identifier string         `json:"identifier"`
+	Title      string         `json:"title"`
+	Priority   int            `json:"priority"`
+	Estimate   *float64       `json:"estimate,omitempty"`
+	DueDate    string         `json:"dueDate,omitempty"`
+	State      IssueState     `json:"state"`
+	Assignee   *IssueAssignee `json:"assignee,omitempty"`
+	Project    *IssueProject  `json:"project,omitempty"`
+	Labels     []IssueLabel   `json:"labels"`
+	CreatedAt  string         `json:"createdAt"`
+	UpdatedAt  string         `json:"updatedAt"`
+	ArchivedAt string         `json:"archivedAt,omitempty"`
+	Trashed    bool           `json:"trashed,omitempty"`
+}
+
+// ChangedProject is a project snapshot returned by the project change feed
+type ChangedProject struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	State      string         `json:"state"`
+	Progress   float64        `json:"progress"`
+	StartDate  string         `json:"startDate,omitempty"`
+	TargetDate string         `json:"targetDate,omitempty"`
+	Lead       *IssueAssignee `json:"lead,omitempty"`
+	URL        string         `json:"url"`
+	CreatedAt  string         `json:"createdAt"`
+	UpdatedAt  string         `json:"updatedAt"`
+	ArchivedAt string         `json:"archivedAt,omitempty"`
+}
+
+// ChangedComment is a comment snapshot returned by the comment change feed
+type ChangedComment struct {
+	ID         string         `json:"id"`
+	Body       string         `json:"body"`
+	User       *IssueAssignee `json:"user,omitempty"`
+	Issue      *IssueParent   `json:"issue,omitempty"`
+	ParentID   string         `json:"parentId,omitempty"`
+	CreatedAt  string         `json:"createdAt"`
+	UpdatedAt  string         `json:"updatedAt"`
+	EditedAt   string         `json:"editedAt,omitempty"`
+	ArchivedAt string         `json:"archivedAt,omitempty"`
+}
+
+// changeFeedArgs builds the connection arguments shared by change feed queries.
+// Archived entities are included so archives and deletions surface as changes.
+func changeFeedArgs(filterParts []string, since, after string, first int) string {
+	if since != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`updatedAt: { gt: %q }`, since))
+	}
+
+	args := []string{
+		fmt.Sprintf("first: %d", first),
+		"orderBy: updatedAt",
+		"includeArchived: true",
+	}
+	if after != "" {
+		args = append(args, fmt.Sprintf("after: %q", after))
+	}
+	if len(filterParts) > 0 {
+		args = append(args, fmt.Sprintf("filter: { %s }", strings.Join(filterParts, ", ")))
+	}
+
+	return strings.Join(args, ", ")
+}
+
+// changeFeedPage returns the page size for the next request given how many
+// items are still wanted (limit <= 0 means no cap)
+func changeFeedPage(limit, fetched int) int {
+	if limit > 0 && limit-fetched < changeFeedPageSize {
+		return limit - fetched
+	}
+	return changeFeedPageSize
+}
+
+// GetIssueChanges fetches issues updated after since (RFC 3339), paginating
+// until exhausted or limit is reached. An empty since returns the most
+// recently updated issues, which is useful for building a baseline.
+func (c *Client) GetIssueChanges(ctx context.Context, filter IssueFilter, since string, limit int) ([]ChangedIssue, error) {
+	filterParts := []string{}
+	if filter.TeamID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`team: { id: { eq: %q } }`, filter.TeamID))
+	}
+	if len(filter.StateTypes) > 0 {
+		types := make([]string, len(filter.StateTypes))
+		for i, t := range filter.StateTypes {
+			types[i] = fmt.Sprintf("%q", t)
+		}
+		filterParts = append(filterParts, fmt.Sprintf(`state: { type: { in: [%s] } }`, strings.Join(types, ", ")))
+	}
+	if filter.Unassigned {
+		filterParts = append(filterParts, `assignee: { null: true }`)
+	} else if filter.AssigneeID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`assignee: { id: { eq: %q } }`, filter.AssigneeID))
+	}
+	if filter.ProjectID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`project: { id: { eq: %q } }`, filter.ProjectID))
+	}
+
+	issues := []ChangedIssue{}
+	after := ""
+
+	for {
+		queryStr := fmt.Sprintf(`query {
+			issues(%s) {
+				nodes {
+					id
+					identifier
+					title
+					priority
+					estimate
+					dueDate
+					createdAt
+					updatedAt
+					archivedAt
+					trashed
+					state {
+						id
+						name
+						type
+						color
+					}
+					assignee {
+						id
+						name
+						displayName
+					}
+					project {
+						id
+						name
+					}
+					labels {
+						nodes {
+							id
+							name
+							color
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`, changeFeedArgs(filterParts, since, after, changeFeedPage(limit, len(issues))))
+
+		var result struct {
+			Issues struct {
+				Nodes []struct {
+					ChangedIssue
+					Labels struct {
+						Nodes []IssueLabel `json:"nodes"`
+					} `json:"labels"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"issues"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		for _, node := range result.Issues.Nodes {
+			issue := node.ChangedIssue
+			issue.Labels = node.Labels.Nodes
+			if issue.Labels == nil {
+				issue.Labels = []IssueLabel{}
+			}
+			issues = append(issues, issue)
+		}
+
+		if !result.Issues.PageInfo.HasNextPage || (limit > 0 && len(issues) >= limit) {
+			break
+		}
+		after = result.Issues.PageInfo.EndCursor
+	}
+
+	return issues, nil
+}
+
+// GetProjectChanges fetches projects updated after since (RFC 3339),
+// optionally limited to a team
+func (c *Client) GetProjectChanges(ctx context.Context, teamID, since string, limit int) ([]ChangedProject, error) {
+	filterParts := []string{}
+	if teamID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`accessibleTeams: { id: { eq: %q } }`, teamID))
+	}
+
+	projects := []ChangedProject{}
+	after := ""
+
+	for {
+		queryStr := fmt.Sprintf(`query {
+			projects(%s) {
+				nodes {
+					id
+					name
+					state
+					progress
+					startDate
+					targetDate
+					url
+					createdAt
+					updatedAt
+					archivedAt
+					lead {
+						id
+						name
+						displayName
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`, changeFeedArgs(filterParts, since, after, changeFeedPage(limit, len(projects))))
+
+		var result struct {
+			Projects struct {
+				Nodes    []ChangedProject `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"projects"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, result.Projects.Nodes...)
+
+		if !result.Projects.PageInfo.HasNextPage || (limit > 0 && len(projects) >= limit) {
+			break
+		}
+		after = result.Projects.PageInfo.EndCursor
+	}
+
+	return projects, nil
+}
+
+// GetCommentChanges fetches comments updated after since (RFC 3339),
+// optionally limited to a single issue (UUID)
+func (c *Client) GetCommentChanges(ctx context.Context, issueID, since string, limit int) ([]ChangedComment, error) {
+	filterParts := []string{}
+	if issueID != "" {
+		filterParts = append(filterParts, fmt.Sprintf(`issue: { id: { eq: %q } }`, issueID))
+	}
+
+	comments := []ChangedComment{}
+	after := ""
+
+	for {
+		queryStr := fmt.Sprintf(`query {
+			comments(%s) {
+				nodes {
+					id
+					body
+					createdAt
+					updatedAt
+					editedAt
+					archivedAt
+					user {
+						id
+						name
+						displayName
+					}
+					issue {
+						id
+						identifier
+						title
+					}
+					parent {
+						id
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`, changeFeedArgs(filterParts, since, after, changeFeedPage(limit, len(comments))))
+
+		var result struct {
+			Comments struct {
+				Nodes []struct {
+					ChangedComment
+					Parent *struct {
+						ID string `json:"id"`
+					} `json:"parent"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"comments"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		for _, node := range result.Comments.Nodes {
+			comment := node.ChangedComment
+			if node.Parent != nil {
+				comment.ParentID = node.Parent.ID
+			}
+			comments = append(comments, comment)
+		}
+
+		if !result.Comments.PageInfo.HasNextPage || (limit > 0 && len(comments) >= limit) {
+			break
+		}
+		after = result.Comments.PageInfo.EndCursor
+	}
+
+	return comments, nil
+}
+
+// ========== Webhooks ==========
+
+// webhookFields is the selection set shared by webhook queries and mutations
+const webhookFields = `
+	id
+	label
+	url
+	enabled
+	secret
+	resourceTypes
+	allPublicTeams
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		name
+	}`
+
+// Webhook represents a Linear webhook
+type Webhook struct {
+	ID             string     `json:"id"`
+	Label          string     `json:"label,omitempty"`
+	URL            string     `json:"url"`
+	Enabled        bool       `json:"enabled"`
+	Secret         string     `json:"secret,omitempty"`
+	ResourceTypes  []string   `json:"resourceTypes"`
+	AllPublicTeams bool       `json:"allPublicTeams"`
+	Team           *IssueTeam `json:"team,omitempty"`
+	CreatedAt      string     `json:"createdAt"`
+	UpdatedAt      string     `json:"updatedAt"`
+}
+
+// WebhooksResponse is the response for listing webhooks
+type WebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+	Count    int       `json:"count"`
+}
+
+// WebhookCreateInput is the input for creating a webhook
+type WebhookCreateInput struct {
+	URL            string   `json:"url"`
+	Label          string   `json:"label,omitempty"`
+	TeamID         string   `json:"teamId,omitempty"`
+	AllPublicTeams bool     `json:"allPublicTeams,omitempty"`
+	ResourceTypes  []string `json:"resourceTypes"`
+	Secret         string   `json:"secret,omitempty"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+}
+
+// WebhookUpdateInput is the input for updating a webhook
+type WebhookUpdateInput struct {
+	URL           string   `json:"url,omitempty"`
+	Label         string   `json:"label,omitempty"`
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+	Secret        string   `json:"secret,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+}
+
+// graphqlStringList formats a string slice as a GraphQL list literal
+func graphqlStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// GetWebhooks fetches the workspace's webhooks
+func (c *Client) GetWebhooks(ctx context.Context, limit int) (*WebhooksResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		webhooks(first: %d) {
+			nodes {%s
+			}
+		}
+	}`, limit, webhookFields)
+
+	var result struct {
+		Webhooks struct {
+			Nodes []Webhook `json:"nodes"`
+		} `json:"webhooks"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	return &WebhooksResponse{
+		Webhooks: result.Webhooks.Nodes,
+		Count:    len(result.Webhooks.Nodes),
+	}, nil
+}
+
+// GetWebhook fetches a single webhook by ID
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	queryStr := fmt.Sprintf(`query {
+		webhook(id: %q) {%s
+		}
+	}`, webhookID, webhookFields)
+
+	var result struct {
+		Webhook Webhook `json:"webhook"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	return &result.Webhook, nil
+}
+
+// CreateWebhook creates a webhook scoped to a team or all public teams
+func (c *Client) CreateWebhook(ctx context.Context, input WebhookCreateInput) (*Webhook, error) {
+	inputParts := []string{
+		fmt.Sprintf(`url: %q`, input.URL),
+		fmt.Sprintf(`resourceTypes: %s`, graphqlStringList(input.ResourceTypes)),
+	}
+
+	if input.Label != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`label: %q`, input.Label))
+	}
+	if input.TeamID != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`teamId: %q`, input.TeamID))
+	}
+	if input.AllPublicTeams {
+		inputParts = append(inputParts, `allPublicTeams: true`)
+	}
+	if input.Secret != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`secret: %q`, input.Secret))
+	}
+	if input.Enabled != nil {
+		inputParts = append(inputParts, fmt.Sprintf(`enabled: %t`, *input.Enabled))
+	}
+
+	mutationStr := fmt.Sprintf(`mutation {
+		webhookCreate(input: { %s }) {
+			success
+			webhook {%s
+			}
+		}
+	}`, strings.Join(inputParts, ", "), webhookFields)
+
+	var result struct {
+		WebhookCreate struct {
+			Success bool    `json:"success"`
+			Webhook Webhook `json:"webhook"`
+		} `json:"webhookCreate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	if !result.WebhookCreate.Success {
+		return nil, fmt.Errorf("failed to create webhook")
+	}
+
+	return &result.WebhookCreate.Webhook, nil
+}
+
+// UpdateWebhook updates a webhook
+func (c *Client) UpdateWebhook(ctx context.Context, webhookID string, input WebhookUpdateInput) (*Webhook, error) {
+	inputParts := []string{}
+
+	if input.URL != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`url: %q`, input.URL))
+	}
+	if input.Label != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`label: %q`, input.Label))
+	}
+	if len(input.ResourceTypes) > 0 {
+		inputParts = append(inputParts, fmt.Sprintf(`resourceTypes: %s`, graphqlStringList(input.ResourceTypes)))
+	}
+	if input.Secret != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`secret: %q`, input.Secret))
+	}
+	if input.Enabled != nil {
+		inputParts = append(inputParts, fmt.Sprintf(`enabled: %t`, *input.Enabled))
+	}
+
+	if len(inputParts) == 0 {
+		return nil, fmt.Errorf("at least one field must be provided to update")
+	}
+
+	mutationStr := fmt.Sprintf(`mutation {
+		webhookUpdate(id: %q, input: { %s }) {
+			success
+			webhook {%s
+			}
+		}
+	}`, webhookID, strings.Join(inputParts, ", "), webhookFields)
+
+	var result struct {
+		WebhookUpdate struct {
+			Success bool    `json:"success"`
+			Webhook Webhook `json:"webhook"`
+		} `json:"webhookUpdate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	if !result.WebhookUpdate.Success {
+		return nil, fmt.Errorf("failed to update webhook")
+	}
+
+	return &result.WebhookUpdate.Webhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	mutationStr := fmt.Sprintf(`mutation {
+		webhookDelete(id: %q) {
+			success
+		}
+	}`, webhookID)
+
+	var result struct {
+		WebhookDelete struct {
+			Success bool `json:"success"`
+		} `json:"webhookDelete"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.WebhookDelete.Success {
+		return fmt.Errorf("failed to delete webhook")
+	}
+
+	return nil
+}
+
+// ========== Project Relations ==========
+
+// projectRelationFields selects a project relation with both sides
+const projectRelationFields = `
+	id
+	type
+	anchorType
+	relatedAnchorType
+	project { id name slugId state health targetDate url }
+	relatedProject { id name slugId state health targetDate url }
+	projectMilestone { id name targetDate }
+	relatedProjectMilestone { id name targetDate }
+`
+
+// ProjectRelationNode is one side of a project relation
+type ProjectRelationNode struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	SlugID     string `json:"slugId"`
+	State      string `json:"state"`
+	Health     string `json:"health,omitempty"`
+	TargetDate string `json:"targetDate,omitempty"`
+	URL        string `json:"url"`
+}
+
+// ProjectRelation is a dependency between two projects: Project blocks
+// RelatedProject. Anchors say which point of each project the dependency
+// attaches to (start, end, or a milestone).
+type ProjectRelation struct {
+	ID                      string              `json:"id"`
+	Type                    string              `json:"type"`
+	Project                 ProjectRelationNode `json:"project"`
+	RelatedProject          ProjectRelationNode `json:"relatedProject"`
+	AnchorType              string              `json:"anchorType"`
+	RelatedAnchorType       string              `json:"relatedAnchorType"`
+	ProjectMilestone        *IssueMilestone     `json:"projectMilestone,omitempty"`
+	RelatedProjectMilestone *IssueMilestone     `json:"relatedProjectMilestone,omitempty"`
+}
+
+// ProjectRelationsResponse groups a project's relations by direction
+type ProjectRelationsResponse struct {
+	ProjectID string `json:"projectId"`
+	// Upstream relations block this project
+	Upstream []ProjectRelation `json:"upstream"`
+	// Downstream relations are blocked by this project
+	Downstream []ProjectRelation `json:"downstream"`
+}
+
+// ProjectRelationCreateInput is the input for creating a project dependency
+type ProjectRelationCreateInput struct {
+	// ProjectID blocks RelatedProjectID
+	ProjectID                 string `json:"projectId"`
+	RelatedProjectID          string `json:"relatedProjectId"`
+	AnchorType                string `json:"anchorType"`
+	RelatedAnchorType         string `json:"relatedAnchorType"`
+	ProjectMilestoneID        string `json:"projectMilestoneId,omitempty"`
+	RelatedProjectMilestoneID string `json:"relatedProjectMilestoneId,omitempty"`
+}
+
+// GetProjectRelations fetches the dependencies of a project in both
+// directions
+func (c *Client) GetProjectRelations(ctx context.Context, projectID string) (*ProjectRelationsResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		project(id: %q) {
+			id
+			relations(first: 100) {
+				nodes { %s }
+			}
+			inverseRelations(first: 100) {
+				nodes { %s }
+			}
+		}
+	}`, projectID, projectRelationFields, projectRelationFields)
+
+	var result struct {
+		Project struct {
+			ID        string `json:"id"`
+			Relations struct {
+				Nodes []ProjectRelation `json:"nodes"`
+			} `json:"relations"`
+			InverseRelations struct {
+				Nodes []ProjectRelation `json:"nodes"`
+			} `json:"inverseRelations"`
+		} `json:"project"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	response := &ProjectRelationsResponse{
+		ProjectID:  result.Project.ID,
+		Upstream:   []ProjectRelation{},
+		Downstream: []ProjectRelation{},
+	}
+
+	// Both connections can contain a relation, depending on which side it
+	// was created from; sort by which side this project is on
+	seen := map[string]bool{}
+	all := append(result.Project.Relations.Nodes, result.Project.InverseRelations.Nodes...)
+	for _, r := range all {
+		if seen[r.ID] {
+			continue
+		}
+		seen[r.ID] = true
+		if r.RelatedProject.ID == result.Project.ID {
+			response.Upstream = append(response.Upstream, r)
+		} else {
+			response.Downstream = append(response.Downstream, r)
+		}
+	}
+
+	return response, nil
+}
+
+// CreateProjectRelation creates a dependency where ProjectID blocks
+// RelatedProjectID
+func (c *Client) CreateProjectRelation(ctx context.Context, input ProjectRelationCreateInput) (*ProjectRelation, error) {
+	inputParts := []string{
+		`type: "dependency"`,
+		fmt.Sprintf(`projectId: %q`, input.ProjectID),
+		fmt.Sprintf(`relatedProjectId: %q`, input.RelatedProjectID),
+		fmt.Sprintf(`anchorType: %q`, input.AnchorType),
+		fmt.Sprintf(`relatedAnchorType: %q`, input.RelatedAnchorType),
+	}
+	if input.ProjectMilestoneID != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`projectMilestoneId: %q`, input.ProjectMilestoneID))
+	}
+	if input.RelatedProjectMilestoneID != "" {
+		inputParts = append(inputParts, fmt.Sprintf(`relatedProjectMilestoneId: %q`, input.RelatedProjectMilestoneID))
+	}
+
+	mutationStr := fmt.Sprintf(`mutation {
+		projectRelationCreate(input: { %s }) {
+			success
+			projectRelation { %s }
+		}
+	}`, strings.Join(inputParts, ", "), projectRelationFields)
+
+	var result struct {
+		ProjectRelationCreate struct {
+			Success         bool            `json:"success"`
+			ProjectRelation ProjectRelation `json:"projectRelation"`
+		} `json:"projectRelationCreate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectRelationCreate.Success {
+		return nil, fmt.Errorf("failed to create project relation")
+	}
+
+	return &result.ProjectRelationCreate.ProjectRelation, nil
+}
+
+// DeleteProjectRelation removes a project dependency
+func (c *Client) DeleteProjectRelation(ctx context.Context, relationID string) error {
+	mutationStr := fmt.Sprintf(`mutation {
+		projectRelationDelete(id: %q) {
+			success
+		}
+	}`, relationID)
+
+	var result struct {
+		ProjectRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"projectRelationDelete"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.ProjectRelationDelete.Success {
+		return fmt.Errorf("failed to delete project relation")
+	}
+
+	return nil
+}
+
+// ========== Project Labels ==========
+
+// GetProjectLabels fetches the workspace's project labels, with their label
+// groups
+func (c *Client) GetProjectLabels(ctx context.Context) ([]AvailableLabel, error) {
+	queryStr := `query {
+		projectLabels(first: 250) {
+			nodes {
+				id
+				name
+				color
+				isGroup
+				parent {
+					id
+					name
+				}
+			}
+		}
+	}`
+
+	var result struct {
+		ProjectLabels struct {
+			Nodes []struct {
+				ID      string `json:"id"`
+				Name    string `json:"name"`
+				Color   string `json:"color"`
+				IsGroup bool   `json:"isGroup"`
+				Parent  *struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"parent"`
+			} `json:"nodes"`
+		} `json:"projectLabels"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	labels := make([]AvailableLabel, len(result.ProjectLabels.Nodes))
+	for i, n := range result.ProjectLabels.Nodes {
+		labels[i] = AvailableLabel{
+			ID:      n.ID,
+			Name:    n.Name,
+			Color:   n.Color,
+			IsGroup: n.IsGroup,
+		}
+		if n.Parent != nil {
+			labels[i].ParentID = n.Parent.ID
+			labels[i].ParentName = n.Parent.Name
+		}
+	}
+
+	return labels, nil
+}
+
+// AddProjectLabel applies a label to a project and returns the project's
+// labels afterwards
+func (c *Client) AddProjectLabel(ctx context.Context, projectID, labelID string) ([]ProjectLabel, error) {
+	return c.changeProjectLabel(ctx, "projectAddLabel", projectID, labelID)
+}
+
+// RemoveProjectLabel removes a label from a project and returns the
+// project's labels afterwards
+func (c *Client) RemoveProjectLabel(ctx context.Context, projectID, labelID string) ([]ProjectLabel, error) {
+	return c.changeProjectLabel(ctx, "projectRemoveLabel", projectID, labelID)
+}
+
+func (c *Client) changeProjectLabel(ctx context.Context, mutation, projectID, labelID string) ([]ProjectLabel, error) {
+	mutationStr := fmt.Sprintf(`mutation {
+		%s(id: %q, labelId: %q) {
+			success
+			project {
+				labels {
+					nodes {
+						id
+						name
+						color
+					}
+				}
+			}
+		}
+	}`, mutation, projectID, labelID)
+
+	type labelPayload struct {
+		Success bool `json:"success"`
+		Project struct {
+			Labels struct {
+				Nodes []ProjectLabel `json:"nodes"`
+			} `json:"labels"`
+		} `json:"project"`
+	}
+
+	// Only the field for the mutation that ran is populated
+	var result struct {
+		ProjectAddLabel    *labelPayload `json:"projectAddLabel"`
+		ProjectRemoveLabel *labelPayload `json:"projectRemoveLabel"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	payload := result.ProjectAddLabel
+	if payload == nil {
+		payload = result.ProjectRemoveLabel
+	}
+	if payload == nil || !payload.Success {
+		return nil, fmt.Errorf("failed to update project labels")
+	}
+
+	return payload.Project.Labels.Nodes, nil
+}
+
+// ========== Project Members ==========
+
+// ProjectMember is a user who is a member of a project
+type ProjectMember struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	Email       string `json:"email,omitempty"`
+}
+
+// GetProjectMembers fetches all of a project's members
+func (c *Client) GetProjectMembers(ctx context.Context, projectID string) ([]ProjectMember, error) {
+	members := []ProjectMember{}
+	after := ""
+	for {
+		afterArg := ""
+		if after != "" {
+			afterArg = fmt.Sprintf(", after: %q", after)
+		}
+		queryStr := fmt.Sprintf(`query {
+		project(id: %q) {
+			members(first: 250%s) {
+				nodes {
+					id
+					name
+					displayName
+					email
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`, projectID, afterArg)
+
+		var result struct {
+			Project struct {
+				Members struct {
+					Nodes    []ProjectMember `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"members"`
+			} `json:"project"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		members = append(members, result.Project.Members.Nodes...)
+		if !result.Project.Members.PageInfo.HasNextPage {
+			return members, nil
+		}
+		after = result.Project.Members.PageInfo.EndCursor
+	}
+}
+
+// SetProjectMembers replaces a project's members and returns the new member
+// list. Linear has no add/remove member mutations, so callers read the
+// current members, change the set, and write it back.
+func (c *Client) SetProjectMembers(ctx context.Context, projectID string, memberIDs []string) ([]ProjectMember, error) {
+	mutationStr := fmt.Sprintf(`mutation {
+		projectUpdate(id: %q, input: { memberIds: %s }) {
+			success
+		}
+	}`, projectID, graphqlStringList(memberIDs))
+
+	var result struct {
+		ProjectUpdate struct {
+			Success bool `json:"success"`
+		} `json:"projectUpdate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	if !result.ProjectUpdate.Success {
+		return nil, fmt.Errorf("failed to update project members")
+	}
+
+	return c.GetProjectMembers(ctx, projectID)
+}
+
+// ========== Project Issues ==========
+
+// ProjectIssue is an issue in a project, with the fields needed to report
+// on the project's progress
+type ProjectIssue struct {
+	ID          string          `json:"id"`
+	Identifier  string          `json:"identifier"`
+	Title       string          `json:"title"`
+	URL         string          `json:"url"`
+	Priority    int             `json:"priority"`
+	Estimate    float64         `json:"estimate,omitempty"`
+	DueDate     string          `json:"dueDate,omitempty"`
+	CompletedAt string          `json:"completedAt,omitempty"`
+	State       IssueState      `json:"state"`
+	Assignee    *IssueAssignee  `json:"assignee,omitempty"`
+	Milestone   *IssueMilestone `json:"milestone,omitempty"`
+	// BlockedBy lists the open issues blocking this one
+	BlockedBy []RelatedIssueRef `json:"blockedBy,omitempty"`
+}
+
+// RelatedIssueRef is a reference to another issue
+type RelatedIssueRef struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+}
+
+// GetProjectIssues fetches all issues in a project, paginating through
+// the results
+func (c *Client) GetProjectIssues(ctx context.Context, projectID string) ([]ProjectIssue, error) {
+	issues := []ProjectIssue{}
+	after := ""
+
+	for {
+		afterPart := ""
+		if after != "" {
+			afterPart = fmt.Sprintf(", after: %q", after)
+		}
+
+		queryStr := fmt.Sprintf(`query {
+			issues(first: 100, filter: { project: { id: { eq: %q } } }%s) {
+				nodes {
+					id
+					identifier
+					title
+					url
+					priority
+					estimate
+					dueDate
+					completedAt
+					state {
+						id
+						name
+						type
+						color
+					}
+					assignee {
+						id
+						name
+						displayName
+					}
+					projectMilestone {
+						id
+						name
+						targetDate
+					}
+					inverseRelations {
+						nodes {
+							type
+							issue {
+								id
+								identifier
+								title
+								state {
+									type
+								}
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}`, projectID, afterPart)
+
+		var result struct {
+			Issues struct {
+				Nodes []struct {
+					ID               string          `json:"id"`
+					Identifier       string          `json:"identifier"`
+					Title            string          `json:"title"`
+					URL              string          `json:"url"`
+					Priority         int             `json:"priority"`
+					Estimate         float64         `json:"estimate"`
+					DueDate          string          `json:"dueDate"`
+					CompletedAt      string          `json:"completedAt"`
+					State            IssueState      `json:"state"`
+					Assignee         *IssueAssignee  `json:"assignee"`
+					ProjectMilestone *IssueMilestone `json:"projectMilestone"`
+					InverseRelations struct {
+						Nodes []struct {
+							Type  string `json:"type"`
+							Issue struct {
+								RelatedIssueRef
+								State struct {
+									Type string `json:"type"`
+								} `json:"state"`
+							} `json:"issue"`
+						} `json:"nodes"`
+					} `json:"inverseRelations"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"issues"`
+		}
+
+		if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+			return nil, err
+		}
+
+		for _, n := range result.Issues.Nodes {
+			issue := ProjectIssue{
+				ID:          n.ID,
+				Identifier:  n.Identifier,
+				Title:       n.Title,
+				URL:         n.URL,
+				Priority:    n.Priority,
+				Estimate:    n.Estimate,
+				DueDate:     n.DueDate,
+				CompletedAt: n.CompletedAt,
+				State:       n.State,
+				Assignee:    n.Assignee,
+				Milestone:   n.ProjectMilestone,
+			}
+			// An inverse "blocks" relation means the other issue blocks
+			// this one; finished blockers no longer count
+			for _, r := range n.InverseRelations.Nodes {
+				if r.Type != "blocks" {
+					continue
+				}
+				if r.Issue.State.Type == "completed" || r.Issue.State.Type == "canceled" {
+					continue
+				}
+				issue.BlockedBy = append(issue.BlockedBy, r.Issue.RelatedIssueRef)
+			}
+			issues = append(issues, issue)
+		}
+
+		if !result.Issues.PageInfo.HasNextPage {
+			break
+		}
+		after = result.Issues.PageInfo.EndCursor
+	}
+
+	return issues, nil
+}
+
+// ========== Initiative Updates ==========
+
+// GetInitiativeUpdates fetches status updates for an initiative. They have
+// the same shape as project updates.
+func (c *Client) GetInitiativeUpdates(ctx context.Context, initiativeID string, limit int) (*ProjectUpdatesResponse, error) {
+	queryStr := fmt.Sprintf(`query {
+		initiative(id: %q) {
+			initiativeUpdates(first: %d) {
+				nodes {
+					id
+					body
+					health
+					createdAt
+					user {
+						id
+						displayName
+					}
+				}
+			}
+		}
+	}`, initiativeID, limit)
+
+	var result struct {
+		Initiative struct {
+			InitiativeUpdates struct {
+				Nodes []ProjectUpdate `json:"nodes"`
+			} `json:"initiativeUpdates"`
+		} `json:"initiative"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	updates := result.Initiative.InitiativeUpdates.Nodes
+	if updates == nil {
+		updates = []ProjectUpdate{}
+	}
+
+	return &ProjectUpdatesResponse{
+		Updates: updates,
+		Count:   len(updates),
+	}, nil
+}
+
+// CreateInitiativeUpdate creates a status update for an initiative
+func (c *Client) CreateInitiativeUpdate(ctx context.Context, initiativeID, body string, health *string) (*ProjectUpdate, error) {
+	inputParts := []string{
+		fmt.Sprintf(`initiativeId: %q`, initiativeID),
+		fmt.Sprintf(`body: %q`, body),
+	}
+	if health != nil {
+		inputParts = append(inputParts, fmt.Sprintf(`health: %s`, *health))
+	}
+
+	mutationStr := fmt.Sprintf(`mutation {
+		initiativeUpdateCreate(input: { %s }) {
+			success
+			initiativeUpdate {
+				id
+				body
+				health
+				createdAt
+				user {
+					id
+					displayName
+				}
+			}
+		}
+	}`, strings.Join(inputParts, ", "))
+
+	var result struct {
+		InitiativeUpdateCreate struct {
+			Success          bool          `json:"success"`
+			InitiativeUpdate ProjectUpdate `json:"initiativeUpdate"`
+		} `json:"initiativeUpdateCreate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	if !result.InitiativeUpdateCreate.Success {
+		return nil, fmt.Errorf("failed to create initiative update")
+	}
+
+	return &result.InitiativeUpdateCreate.InitiativeUpdate, nil
+}
+
+// ========== Sub-initiatives ==========
+
+// AddSubInitiative nests childID under parentID
+func (c *Client) AddSubInitiative(ctx context.Context, parentID, childID string) error {
+	mutationStr := fmt.Sprintf(`mutation {
+		initiativeRelationCreate(input: { initiativeId: %q, relatedInitiativeId: %q }) {
+			success
+		}
+	}`, parentID, childID)
+
+	var result struct {
+		InitiativeRelationCreate struct {
+			Success bool `json:"success"`
+		} `json:"initiativeRelationCreate"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.InitiativeRelationCreate.Success {
+		return fmt.Errorf("failed to add sub-initiative")
+	}
+
+	return nil
+}
+
+// RemoveSubInitiative removes childID from under parentID
+func (c *Client) RemoveSubInitiative(ctx context.Context, parentID, childID string) error {
+	queryStr := fmt.Sprintf(`query {
+		initiativeRelations(filter: { initiative: { id: { eq: %q } } }) {
+			nodes {
+				id
+				relatedInitiative {
+					id
+				}
+			}
+		}
+	}`, parentID)
+
+	var queryResult struct {
+		InitiativeRelations struct {
+			Nodes []struct {
+				ID                string `json:"id"`
+				RelatedInitiative struct {
+					ID string `json:"id"`
+				} `json:"relatedInitiative"`
+			} `json:"nodes"`
+		} `json:"initiativeRelations"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &queryResult, nil); err != nil {
+		return err
+	}
+
+	var relationID string
+	for _, r := range queryResult.InitiativeRelations.Nodes {
+		if r.RelatedInitiative.ID == childID {
+			relationID = r.ID
+			break
+		}
+	}
+
+	if relationID == "" {
+		return fmt.Errorf("initiative %s is not a sub-initiative of %s", childID, parentID)
+	}
+
+	mutationStr := fmt.Sprintf(`mutation {
+		initiativeRelationDelete(id: %q) {
+			success
+		}
+	}`, relationID)
+
+	var result struct {
+		InitiativeRelationDelete struct {
+			Success bool `json:"success"`
+		} `json:"initiativeRelationDelete"`
+	}
+
+	if err := c.graphql.Exec(ctx, mutationStr, &result, nil); err != nil {
+		return err
+	}
+
+	if !result.InitiativeRelationDelete.Success {
+		return fmt.Errorf("failed to remove sub-initiative")
+	}
+
+	return nil
+}
+
+// GetSubInitiatives fetches an initiative's direct sub-initiatives
+func (c *Client) GetSubInitiatives(ctx context.Context, initiativeID string) ([]InitiativeRef, error) {
+	queryStr := fmt.Sprintf(`query {
+		initiative(id: %q) {
+			subInitiatives {
+				nodes {
+					id
+					name
+					status
+					targetDate
+				}
+			}
+		}
+	}`, initiativeID)
+
+	var result struct {
+		Initiative struct {
+			SubInitiatives struct {
+				Nodes []InitiativeRef `json:"nodes"`
+			} `json:"subInitiatives"`
+		} `json:"initiative"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	children := result.Initiative.SubInitiatives.Nodes
+	if children == nil {
+		children = []InitiativeRef{}
+	}
+
+	return children, nil
+}
+
+// ========== Roadmap ==========
+
+// RoadmapProject is a project with the dates needed to draw it on a
+// timeline
+type RoadmapProject struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	State       string             `json:"state"`
+	Health      string             `json:"health,omitempty"`
+	Progress    float64            `json:"progress"`
+	StartDate   string             `json:"startDate,omitempty"`
+	TargetDate  string             `json:"targetDate,omitempty"`
+	URL         string             `json:"url"`
+	Milestones  []RoadmapMilestone `json:"milestones"`
+	Initiatives []InitiativeRef    `json:"initiatives"`
+}
+
+// RoadmapMilestone is a project milestone on the roadmap. Progress is the
+// share of its issues completed, from 0 to 1.
+type RoadmapMilestone struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	TargetDate string  `json:"targetDate,omitempty"`
+	Progress   float64 `json:"progress"`
+}
+
+// GetRoadmapProjects fetches projects matching the filter with their
+// milestones and initiatives
+func (c *Client) GetRoadmapProjects(ctx context.Context, filter ProjectFilter, limit int) ([]RoadmapProject, error) {
+	queryStr := fmt.Sprintf(`query {
+		projects(first: %d%s) {
+			nodes {
+				id
+				name
+				state
+				health
+				progress
+				startDate
+				targetDate
+				url
+				projectMilestones {
+					nodes {
+						id
+						name
+						targetDate
+						progress
+					}
+				}
+				initiatives {
+					nodes {
+						id
+						name
+						status
+						targetDate
+					}
+				}
+			}
+		}
+	}`, limit, filter.filterArg())
+
+	var result struct {
+		Projects struct {
+			Nodes []struct {
+				ID                string  `json:"id"`
+				Name              string  `json:"name"`
+				State             string  `json:"state"`
+				Health            string  `json:"health"`
+				Progress          float64 `json:"progress"`
+				StartDate         string  `json:"startDate"`
+				TargetDate        string  `json:"targetDate"`
+				URL               string  `json:"url"`
+				ProjectMilestones struct {
+					Nodes []RoadmapMilestone `json:"nodes"`
+				} `json:"projectMilestones"`
+				Initiatives struct {
+					Nodes []InitiativeRef `json:"nodes"`
+				} `json:"initiatives"`
+			} `json:"nodes"`
+		} `json:"projects"`
+	}
+
+	if err := c.graphql.Exec(ctx, queryStr, &result, nil); err != nil {
+		return nil, err
+	}
+
+	projects := make([]RoadmapProject, len(result.Projects.Nodes))
+	for i, n := range result.Projects.Nodes {
+		projects[i] = RoadmapProject{
+			ID:          n.ID,
+			Name:        n.Name,
+			State:       n.State,
+			Health:      n.Health,
+			Progress:    n.Progress,
+			StartDate:   n.StartDate,
+			TargetDate:  n.TargetDate,
+			URL:         n.URL,
+			Milestones:  n.ProjectMilestones.Nodes,
+			Initiatives: n.Initiatives.Nodes,
+		}
+		if projects[i].Milestones == nil {
+			projects[i].Milestones = []RoadmapMilestone{}
+		}
+		if projects[i].Initiatives == nil {
+			projects[i].Initiatives = []InitiativeRef{}
+		}
+	}
+
+	return projects, nil
+}
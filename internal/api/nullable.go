@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable is a tri-state field in update inputs: unset (the zero value,
+// left out of the mutation), set to a value, or null (clears the field in
+// Linear)
+type Nullable struct {
+	value string
+	state nullableState
+}
+
+type nullableState int
+
+const (
+	nullableUnset nullableState = iota
+	nullableValue
+	nullableNull
+)
+
+// Value returns a Nullable set to v. An empty v stays unset, so flag values
+// can be passed through directly.
+func Value(v string) Nullable {
+	if v == "" {
+		return Nullable{}
+	}
+	return Nullable{value: v, state: nullableValue}
+}
+
+// Null returns a Nullable that clears the field
+func Null() Nullable {
+	return Nullable{state: nullableNull}
+}
+
+// IsUnset reports whether the field is left unchanged
+func (n Nullable) IsUnset() bool {
+	return n.state == nullableUnset
+}
+
+// IsNull reports whether the field is cleared
+func (n Nullable) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// String returns the value, or "" when unset or null
+func (n Nullable) String() string {
+	return n.value
+}
+
+// MarshalJSON encodes the value, or null for a cleared field. Unset fields
+// are the zero value, so tag them `json:",omitzero"` to leave them out.
+func (n Nullable) MarshalJSON() ([]byte, error) {
+	if n.state != nullableValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// appendNullable adds `field: "value"` or `field: null` to GraphQL input
+// parts unless n is unset
+func appendNullable(parts []string, field string, n Nullable) []string {
+	switch n.state {
+	case nullableValue:
+		return append(parts, fmt.Sprintf(`%s: %q`, field, n.value))
+	case nullableNull:
+		return append(parts, field+": null")
+	}
+	return parts
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNullable(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         Nullable
+		wantUnset bool
+		wantNull  bool
+		wantParts []string
+	}{
+		{
+			name:      "zero value",
+			n:         Nullable{},
+			wantUnset: true,
+		},
+		{
+			name:      "empty value stays unset",
+			n:         Value(""),
+			wantUnset: true,
+		},
+		{
+			name:      "value",
+			n:         Value(`a "b"`),
+			wantParts: []string{`dueDate: "a \"b\""`},
+		},
+		{
+			name:      "null",
+			n:         Null(),
+			wantNull:  true,
+			wantParts: []string{"dueDate: null"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.n.IsUnset(); got != tt.wantUnset {
+				t.Errorf("IsUnset() = %t, want %t", got, tt.wantUnset)
+			}
+			if got := tt.n.IsNull(); got != tt.wantNull {
+				t.Errorf("IsNull() = %t, want %t", got, tt.wantNull)
+			}
+			if got := appendNullable(nil, "dueDate", tt.n); !reflect.DeepEqual(got, tt.wantParts) {
+				t.Errorf("appendNullable() = %q, want %q", got, tt.wantParts)
+			}
+		})
+	}
+}
+
+func TestNullableJSON(t *testing.T) {
+	input := IssueUpdateInput{
+		AssigneeID: Value("user-1"),
+		DueDate:    Null(),
+		ProjectID:  Value(""),
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["assigneeId"] != "user-1" {
+		t.Errorf("assigneeId = %v, want user-1", got["assigneeId"])
+	}
+	if v, ok := got["dueDate"]; !ok || v != nil {
+		t.Errorf("dueDate = %v (present %t), want null", v, ok)
+	}
+	for _, field := range []string{"projectId", "parentId", "cycleId", "projectMilestoneId"} {
+		if _, ok := got[field]; ok {
+			t.Errorf("unset %s is encoded: %s", field, data)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// TraceOptions configures request tracing for every client created
+// afterwards
+type TraceOptions struct {
+	// Debug logs a summary of each request to stderr
+	Debug bool
+	// File receives every request/response pair as a HAR 1.2 log
+	File string
+	// Version is recorded as the HAR creator version
+	Version string
+}
+
+// tracer records GraphQL traffic for debugging
+type tracer struct {
+	debug   bool
+	file    string
+	version string
+
+	mu sync.Mutex
+	// f is the open trace file and end the offset where the next entry is
+	// written, just before the closing brackets
+	f       *os.File
+	end     int64
+	entries int
+}
+
+var activeTracer *tracer
+
+// ConfigureTracing enables debug logging and/or HAR tracing. LINEAR_DEBUG
+// enables debug logging as well.
+func ConfigureTracing(opts TraceOptions) {
+	if !opts.Debug {
+		opts.Debug = debugFromEnv()
+	}
+	if !opts.Debug && opts.File == "" {
+		activeTracer = nil
+		return
+	}
+	activeTracer = &tracer{
+		debug:   opts.Debug,
+		file:    opts.File,
+		version: opts.Version,
+	}
+}
+
+func debugFromEnv() bool {
+	switch strings.ToLower(os.Getenv("LINEAR_DEBUG")) {
+	case "", "0", "false", "no", "off":
+		return false
+	}
+	return true
+}
+
+// roundTrip performs the request through base and records it
+func (t *tracer) roundTrip(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = body
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	var respBody []byte
+	if err == nil {
+		respBody, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if err != nil {
+			resp = nil
+		}
+	}
+
+	if t.debug {
+		t.logDebug(req, reqBody, resp, respBody, elapsed, err)
+	}
+	if t.file != "" {
+		t.record(req, reqBody, resp, respBody, start, elapsed, err)
+	}
+
+	return resp, err
+}
+
+// graphqlOperationPattern captures the operation type, optional name and
+// first selected field of a GraphQL document
+var graphqlOperationPattern = regexp.MustCompile(`^\s*(query|mutation|subscription)?\s*([A-Za-z_][A-Za-z0-9_]*)?[^{]*\{\s*([A-Za-z_][A-Za-z0-9_]*)`)
+
+// describeOperation returns "query Name" for a request body, falling back
+// to the first root field for anonymous operations
+func describeOperation(body []byte) (string, json.RawMessage) {
+	var payload struct {
+		Query         string          `json:"query"`
+		OperationName string          `json:"operationName"`
+		Variables     json.RawMessage `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "-", nil
+	}
+
+	m := graphqlOperationPattern.FindStringSubmatch(payload.Query)
+	if m == nil {
+		return "-", payload.Variables
+	}
+
+	kind := m[1]
+	if kind == "" {
+		kind = "query"
+	}
+	name := payload.OperationName
+	if name == "" {
+		name = m[2]
+	}
+	if name == "" {
+		name = m[3]
+	}
+	return kind + " " + name, payload.Variables
+}
+
+func (t *tracer) logDebug(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, elapsed time.Duration, err error) {
+	operation, variables := describeOperation(reqBody)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[debug] %s %s %s", req.Method, req.URL.Host, operation)
+	if len(variables) > 0 && string(variables) != "null" && string(variables) != "{}" {
+		fmt.Fprintf(&b, " variables=%s", variables)
+	}
+	fmt.Fprintf(&b, " duration=%s", elapsed.Round(time.Millisecond))
+
+	if err != nil {
+		fmt.Fprintf(&b, " error=%q", err.Error())
+	} else {
+		fmt.Fprintf(&b, " status=%d size=%dB", resp.StatusCode, len(respBody))
+		for _, h := range rateLimitHeaders(resp.Header) {
+			fmt.Fprintf(&b, " %s=%s", h.Name, h.Value)
+		}
+	}
+
+	fmt.Fprintln(output.Stderr, b.String())
+}
+
+// rateLimitHeaders returns Linear's X-RateLimit-* headers, sorted by name
+func rateLimitHeaders(header http.Header) []harNameValue {
+	var headers []harNameValue
+	for name, values := range header {
+		if strings.HasPrefix(strings.ToLower(name), "x-ratelimit-") && len(values) > 0 {
+			headers = append(headers, harNameValue{Name: strings.ToLower(name), Value: values[0]})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
+
+// HAR 1.2 structures (http://www.softwareishard.com/blog/har-12-spec/)
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+func harHeaders(header http.Header) []harNameValue {
+	headers := []harNameValue{}
+	for name, values := range header {
+		for _, v := range values {
+			if strings.EqualFold(name, "Authorization") {
+				v = output.RedactedMask
+			}
+			headers = append(headers, harNameValue{Name: name, Value: v})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Name < headers[j].Name })
+	return headers
+}
+
+// record appends an entry to the trace file. The closing brackets are
+// rewritten after every entry, so the log stays valid JSON even if the
+// process is interrupted.
+func (t *tracer) record(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, start time.Time, elapsed time.Duration, err error) {
+	ms := float64(elapsed.Microseconds()) / 1000
+
+	entry := harEntry{
+		StartedDateTime: start.UTC().Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: req.Proto,
+			Headers:     harHeaders(req.Header),
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+		},
+		Timings: harTimings{Send: 0, Wait: ms, Receive: 0},
+	}
+	if reqBody != nil {
+		entry.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     string(reqBody),
+		}
+	}
+
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Response.Status = resp.StatusCode
+		entry.Response.StatusText = http.StatusText(resp.StatusCode)
+		entry.Response.HTTPVersion = resp.Proto
+		entry.Response.Headers = harHeaders(resp.Header)
+		entry.Response.BodySize = len(respBody)
+		entry.Response.Content = harContent{
+			Size:     len(respBody),
+			MimeType: resp.Header.Get("Content-Type"),
+			Text:     string(respBody),
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.appendEntry(entry); err != nil {
+		fmt.Fprintf(output.Stderr, "warning: failed to write trace file: %v\n", err)
+	}
+}
+
+// harTrailer closes the entries array and the log object
+const harTrailer = "\n    ]\n  }\n}\n"
+
+// appendEntry writes entry over the trailer and puts the trailer back
+// after it. The file is created with the HAR header on the first entry.
+func (t *tracer) appendEntry(entry harEntry) error {
+	if t.f == nil {
+		version := t.version
+		if version == "" {
+			version = "dev"
+		}
+		creator, err := json.MarshalIndent(harCreator{Name: "agent-linear-cli", Version: version}, "    ", "  ")
+		if err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(t.file, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		header := fmt.Sprintf("{\n  \"log\": {\n    \"version\": \"1.2\",\n    \"creator\": %s,\n    \"entries\": [", creator)
+		if _, err := f.WriteString(header + harTrailer); err != nil {
+			f.Close()
+			return err
+		}
+		t.f = f
+		t.end = int64(len(header))
+	}
+
+	data, err := json.MarshalIndent(entry, "      ", "  ")
+	if err != nil {
+		return err
+	}
+	chunk := "\n      " + output.Redact(string(data))
+	if t.entries > 0 {
+		chunk = "," + chunk
+	}
+
+	if _, err := t.f.WriteAt([]byte(chunk+harTrailer), t.end); err != nil {
+		return err
+	}
+	t.end += int64(len(chunk))
+	t.entries++
+	return nil
+}
@@ -2,14 +2,14 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"net/url"
 	"os"
 	"strings"
 	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
 )
 
 const (
@@ -36,15 +36,67 @@ const (
 	AuthMethodNone              AuthMethod = "none"
 	AuthMethodAPIKey            AuthMethod = "api_key"
 	AuthMethodClientCredentials AuthMethod = "client_credentials"
+	AuthMethodOAuth             AuthMethod = "oauth"
 )
 
 // TokenInfo contains OAuth token information
 type TokenInfo struct {
-	AccessToken string    `json:"access_token"`
-	TokenType   string    `json:"token_type"`
-	ExpiresIn   int       `json:"expires_in"`
-	ExpiresAt   time.Time `json:"expires_at"`
-	Scope       string    `json:"scope,omitempty"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type"`
+	ExpiresIn    int       `json:"expires_in"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	Scope        string    `json:"scope,omitempty"`
+	// Grant records how the token was issued (oauth or client_credentials)
+	Grant AuthMethod `json:"grant,omitempty"`
+}
+
+// Method returns how the token was obtained, as recorded when it was
+// issued. Tokens stored before the grant was recorded report
+// AuthMethodNone unless they carry a refresh token, which only browser
+// login issues.
+func (t *TokenInfo) Method() AuthMethod {
+	if t.Grant != "" {
+		return t.Grant
+	}
+	if t.RefreshToken != "" {
+		return AuthMethodOAuth
+	}
+	return AuthMethodNone
+}
+
+// tokenMethod returns the method of a stored token. A token without a
+// recorded grant counts as client credentials only when a client secret
+// is stored alongside it.
+func (m *Manager) tokenMethod(t *TokenInfo) AuthMethod {
+	if method := t.Method(); method != AuthMethodNone {
+		return method
+	}
+	if secret, err := m.storage.GetClientSecret(); err == nil && secret != "" {
+		return AuthMethodClientCredentials
+	}
+	return AuthMethodOAuth
+}
+
+// Scopes returns the token's granted scopes
+func (t *TokenInfo) Scopes() []string {
+	return strings.FieldsFunc(t.Scope, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// ReadOnlyScopes reports whether a scope list grants no write access.
+// An empty list means the scopes are unknown and is not treated as read-only.
+func ReadOnlyScopes(scopes []string) bool {
+	if len(scopes) == 0 {
+		return false
+	}
+	for _, s := range scopes {
+		if s != "read" {
+			return false
+		}
+	}
+	return true
 }
 
 // AuthStatus represents the current authentication status
@@ -67,13 +119,62 @@ type UserInfo struct {
 
 // Manager handles authentication operations
 type Manager struct {
-	storage Storage
+	storage   Storage
+	selection *StorageSelection
 }
 
-// NewManager creates a new auth manager
+// NewManager creates a new auth manager using the selected storage backend
 func NewManager() *Manager {
+	selection, err := SelectStorage()
+	if err != nil {
+		fmt.Fprintf(output.Stderr, "warning: %v; selecting storage automatically\n", err)
+		available := KeyringAvailable()
+		selection = &StorageSelection{Backend: autoBackend(available), Source: "auto", KeyringAvailable: available}
+	}
+
+	storage, err := NewStorage(selection.Backend)
+	if err != nil {
+		fmt.Fprintf(output.Stderr, "warning: %v; using system keyring\n", err)
+		selection.Backend = StorageKeyring
+		storage = NewKeyringStorage()
+	}
+
 	return &Manager{
-		storage: NewKeyringStorage(),
+		storage:   storage,
+		selection: selection,
+	}
+}
+
+// StorageSelection returns the active storage backend and why it was chosen
+func (m *Manager) StorageSelection() *StorageSelection {
+	return m.selection
+}
+
+// StorageName returns the short name of the active storage, as reported in
+// status sources ("keychain", "file" or "helper")
+func (m *Manager) StorageName() string {
+	if m.selection == nil {
+		return "keychain"
+	}
+	switch m.selection.Backend {
+	case StorageFile:
+		return "file"
+	case StorageHelper:
+		return "helper"
+	default:
+		return "keychain"
+	}
+}
+
+// StorageLabel returns a human-readable description of the active storage
+func (m *Manager) StorageLabel() string {
+	switch m.StorageName() {
+	case "file":
+		return "encrypted credentials file"
+	case "helper":
+		return "credential helper"
+	default:
+		return "system keychain"
 	}
 }
 
@@ -107,9 +208,21 @@ func (m *Manager) GetToken(ctx context.Context) (string, AuthMethod, error) {
 	if tokenInfo, err := m.storage.GetTokenInfo(); err == nil && tokenInfo != nil {
 		// Check if token needs refresh
 		if time.Now().Add(TokenExpiryBuffer).Before(tokenInfo.ExpiresAt) {
-			return tokenInfo.AccessToken, AuthMethodClientCredentials, nil
+			return tokenInfo.AccessToken, m.tokenMethod(tokenInfo), nil
 		}
-		// Token expired, try to refresh using stored credentials
+		// Token expired, refresh with the refresh token from browser login
+		if tokenInfo.RefreshToken != "" {
+			storedClientID, _ := m.storage.GetClientID()
+			if storedClientID == "" {
+				storedClientID = DefaultClientID
+			}
+			token, err := m.refreshAccessToken(ctx, storedClientID, tokenInfo.RefreshToken)
+			if err != nil {
+				return "", AuthMethodNone, fmt.Errorf("token refresh failed: %w (run 'linear auth login --web' again)", err)
+			}
+			return token, AuthMethodOAuth, nil
+		}
+		// Otherwise try to refresh using stored client credentials
 		if clientSecret, err := m.storage.GetClientSecret(); err == nil && clientSecret != "" {
 			storedClientID, _ := m.storage.GetClientID()
 			if storedClientID == "" {
@@ -126,6 +239,17 @@ func (m *Manager) GetToken(ctx context.Context) (string, AuthMethod, error) {
 	return "", AuthMethodNone, errors.New("not authenticated: run 'linear auth login' or set LINEAR_API_KEY (get key from https://linear.app/settings/api)")
 }
 
+// StoredTokenInfo returns the stored OAuth token, if any
+func (m *Manager) StoredTokenInfo() (*TokenInfo, error) {
+	return m.storage.GetTokenInfo()
+}
+
+// StoredClientID returns the stored OAuth client ID, if any
+func (m *Manager) StoredClientID() string {
+	id, _ := m.storage.GetClientID()
+	return id
+}
+
 // GetStatus returns the current authentication status
 func (m *Manager) GetStatus(ctx context.Context) (*AuthStatus, error) {
 	status := &AuthStatus{
@@ -154,14 +278,14 @@ func (m *Manager) GetStatus(ctx context.Context) (*AuthStatus, error) {
 	if apiKey, err := m.storage.GetAPIKey(); err == nil && apiKey != "" {
 		status.Authenticated = true
 		status.Method = AuthMethodAPIKey
-		status.Source = "keychain"
+		status.Source = m.StorageName()
 		return status, nil
 	}
 
 	if tokenInfo, err := m.storage.GetTokenInfo(); err == nil && tokenInfo != nil {
 		status.Authenticated = true
-		status.Method = AuthMethodClientCredentials
-		status.Source = "keychain"
+		status.Method = m.tokenMethod(tokenInfo)
+		status.Source = m.StorageName()
 		status.ExpiresAt = &tokenInfo.ExpiresAt
 		return status, nil
 	}
@@ -217,50 +341,42 @@ func (m *Manager) Logout() error {
 	return nil
 }
 
-// fetchClientCredentialsToken fetches a new token using client credentials grant
-func (m *Manager) fetchClientCredentialsToken(ctx context.Context, clientID, clientSecret string) (string, error) {
-	data := url.Values{
-		"grant_type":    {"client_credentials"},
-		"client_id":     {clientID},
-		"client_secret": {clientSecret},
+// EraseCredential removes the stored credential whose secret is password:
+// the API key, or the cached access token. Anything else is left alone, so
+// a helper caller reporting a rejected credential can't wipe unrelated
+// ones. It reports whether a credential was removed.
+func (m *Manager) EraseCredential(password string) (bool, error) {
+	if password == "" {
+		return false, nil
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", LinearTokenEndpoint, strings.NewReader(data.Encode()))
-	if err != nil {
-		return "", err
+	if apiKey, err := m.storage.GetAPIKey(); err == nil && apiKey == password {
+		return true, m.storage.DeleteAPIKey()
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", err
+	if tokenInfo, err := m.storage.GetTokenInfo(); err == nil && tokenInfo != nil && tokenInfo.AccessToken == password {
+		return true, m.storage.DeleteTokenInfo()
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error            string `json:"error"`
-			ErrorDescription string `json:"error_description"`
-		}
-		json.NewDecoder(resp.Body).Decode(&errResp)
-		if errResp.ErrorDescription != "" {
-			return "", fmt.Errorf("%s: %s", errResp.Error, errResp.ErrorDescription)
-		}
-		return "", fmt.Errorf("token request failed with status %d", resp.StatusCode)
-	}
+	return false, nil
+}
 
-	var tokenResp TokenInfo
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+// fetchClientCredentialsToken fetches a new token using client credentials grant
+func (m *Manager) fetchClientCredentialsToken(ctx context.Context, clientID, clientSecret string) (string, error) {
+	output.RegisterSecret(clientSecret)
+
+	tokenResp, err := requestToken(ctx, url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+	})
+	if err != nil {
 		return "", err
 	}
 
-	// Calculate expiry time
-	tokenResp.ExpiresAt = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-
 	// Store the token
-	if err := m.storage.SetTokenInfo(&tokenResp); err != nil {
+	if err := m.storage.SetTokenInfo(tokenResp); err != nil {
 		// Non-fatal: log but continue
-		fmt.Fprintf(os.Stderr, "warning: failed to cache token: %v\n", err)
+		fmt.Fprintf(output.Stderr, "warning: failed to cache token: %v\n", err)
 	}
 
 	return tokenResp.AccessToken, nil
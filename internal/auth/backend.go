@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// StorageKeyring stores credentials in the system keyring
+	StorageKeyring = "keyring"
+
+	// StorageFile stores credentials in an encrypted file
+	StorageFile = "file"
+
+	// StorageHelper delegates to an external credential helper
+	StorageHelper = "helper"
+
+	// storagePreferenceFile records the backend chosen with 'auth storage'
+	storagePreferenceFile = "storage"
+)
+
+// StorageBackends lists the selectable storage backends
+var StorageBackends = []string{StorageKeyring, StorageFile, StorageHelper}
+
+// StorageSelection describes which backend is active and why
+type StorageSelection struct {
+	Backend          string `json:"backend"`
+	Source           string `json:"source"` // env, config, auto
+	KeyringAvailable bool   `json:"keyringAvailable"`
+	FilePath         string `json:"filePath,omitempty"`
+	HelperCommand    string `json:"helperCommand,omitempty"`
+}
+
+// KeyringAvailable reports whether the system keyring can be used. Lookups
+// of a missing item succeed with ErrNotFound when a keyring is present.
+func KeyringAvailable() bool {
+	_, err := keyring.Get(ServiceName, "availability_probe")
+	return err == nil || errors.Is(err, keyring.ErrNotFound)
+}
+
+// SelectStorage picks the storage backend: LINEAR_CREDENTIAL_STORAGE, then
+// the preference saved by 'linear auth storage', then the keyring if it is
+// available, falling back to the encrypted file
+func SelectStorage() (*StorageSelection, error) {
+	selection := &StorageSelection{KeyringAvailable: KeyringAvailable()}
+
+	if fs, err := NewFileStorage(); err == nil {
+		selection.FilePath = fs.Path()
+	}
+	selection.HelperCommand = HelperCommand()
+
+	if backend := os.Getenv("LINEAR_CREDENTIAL_STORAGE"); backend != "" {
+		selection.Backend, selection.Source = backend, "env"
+	} else if backend := readStoragePreference(); backend != "" {
+		selection.Backend, selection.Source = backend, "config"
+	} else {
+		selection.Backend, selection.Source = autoBackend(selection.KeyringAvailable), "auto"
+	}
+
+	if !isStorageBackend(selection.Backend) {
+		return nil, fmt.Errorf("unknown credential storage %q (valid: %s)", selection.Backend, strings.Join(StorageBackends, ", "))
+	}
+
+	return selection, nil
+}
+
+func autoBackend(keyringAvailable bool) string {
+	if keyringAvailable {
+		return StorageKeyring
+	}
+	return StorageFile
+}
+
+// NewStorage creates a storage backend by name
+func NewStorage(backend string) (Storage, error) {
+	switch backend {
+	case StorageKeyring:
+		return NewKeyringStorage(), nil
+	case StorageFile:
+		return NewFileStorage()
+	case StorageHelper:
+		return NewHelperStorage()
+	default:
+		return nil, fmt.Errorf("unknown credential storage %q (valid: %s)", backend, strings.Join(StorageBackends, ", "))
+	}
+}
+
+// SetStoragePreference saves the backend used when
+// LINEAR_CREDENTIAL_STORAGE is not set
+func SetStoragePreference(backend string) error {
+	if !isStorageBackend(backend) {
+		return fmt.Errorf("unknown credential storage %q (valid: %s)", backend, strings.Join(StorageBackends, ", "))
+	}
+
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, storagePreferenceFile), []byte(backend+"\n"), 0600)
+}
+
+func readStoragePreference() string {
+	dir, err := ConfigDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(dir, storagePreferenceFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func isStorageBackend(name string) bool {
+	for _, b := range StorageBackends {
+		if b == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateStorage copies all credentials from one backend to another and
+// removes them from the source. Everything is copied before anything is
+// deleted, so a failing target leaves the source intact. It returns the
+// number of items moved.
+func MigrateStorage(from, to Storage) (int, error) {
+	var deletes []func() error
+
+	if v, err := from.GetAPIKey(); err == nil && v != "" {
+		if err := to.SetAPIKey(v); err != nil {
+			return 0, err
+		}
+		deletes = append(deletes, from.DeleteAPIKey)
+	}
+	if v, err := from.GetTokenInfo(); err == nil && v != nil {
+		if err := to.SetTokenInfo(v); err != nil {
+			return 0, err
+		}
+		deletes = append(deletes, from.DeleteTokenInfo)
+	}
+	if v, err := from.GetClientID(); err == nil && v != "" {
+		if err := to.SetClientID(v); err != nil {
+			return 0, err
+		}
+		deletes = append(deletes, from.DeleteClientID)
+	}
+	if v, err := from.GetClientSecret(); err == nil && v != "" {
+		if err := to.SetClientSecret(v); err != nil {
+			return 0, err
+		}
+		deletes = append(deletes, from.DeleteClientSecret)
+	}
+
+	// The copies are in place, so a failed delete only leaves a stale
+	// source item behind
+	for _, del := range deletes {
+		del()
+	}
+	return len(deletes), nil
+}
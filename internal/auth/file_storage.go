@@ -0,0 +1,399 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// CredentialsFileName is the encrypted credentials file in the config dir
+	CredentialsFileName = "credentials.enc"
+
+	// KeyFileName is the key file older versions generated beside the
+	// credentials file. It is still read, with a warning.
+	KeyFileName = "credentials.key"
+
+	// pbkdf2Iterations follows the OWASP recommendation for PBKDF2-SHA256
+	pbkdf2Iterations = 600000
+
+	// kdfPBKDF2 stretches a passphrase; kdfKeyFile hashes the contents of a
+	// random key file once, which needs no stretching
+	kdfPBKDF2  = "pbkdf2-sha256"
+	kdfKeyFile = "sha256"
+)
+
+// legacyKeyFileWarning makes sure the warning about a key file stored
+// beside the credentials is printed once per run
+var legacyKeyFileWarning sync.Once
+
+// encryptedFile is the on-disk format of the credentials file
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// FileStorage implements Storage using an AES-GCM encrypted file, for
+// systems without a keyring (containers, CI runners, headless Linux).
+//
+// The encryption key is derived with PBKDF2 from LINEAR_CREDENTIALS_PASSPHRASE,
+// or from the contents of a key file (LINEAR_CREDENTIALS_KEY_FILE, generated
+// on first use). The secret must live outside the config dir: a key kept
+// next to the file it protects is only obfuscation.
+type FileStorage struct {
+	path string
+
+	// derived key cache, keyed by KDF and salt
+	kdf  string
+	salt []byte
+	key  []byte
+}
+
+// NewFileStorage creates a file-based storage in the config dir
+func NewFileStorage() (*FileStorage, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorage{path: filepath.Join(dir, CredentialsFileName)}, nil
+}
+
+// Path returns the location of the encrypted credentials file
+func (s *FileStorage) Path() string {
+	return s.path
+}
+
+// ConfigDir returns the CLI's config directory
+// (XDG_CONFIG_HOME/agent-linear-cli, or ~/.config/agent-linear-cli)
+func ConfigDir() (string, error) {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, ServiceName), nil
+}
+
+// secret returns the material the encryption key is derived from and the
+// KDF that suits it
+func (s *FileStorage) secret() (string, string, error) {
+	if p := os.Getenv("LINEAR_CREDENTIALS_PASSPHRASE"); p != "" {
+		return p, kdfPBKDF2, nil
+	}
+
+	dir := filepath.Dir(s.path)
+	keyFile := os.Getenv("LINEAR_CREDENTIALS_KEY_FILE")
+	if keyFile == "" {
+		// Key files generated beside the credentials by older versions
+		// keep working, loudly
+		keyFile = filepath.Join(dir, KeyFileName)
+		if _, err := os.Stat(keyFile); err != nil {
+			return "", "", fmt.Errorf("encrypted credential storage needs a secret kept outside %s: set LINEAR_CREDENTIALS_PASSPHRASE, or LINEAR_CREDENTIALS_KEY_FILE to a key file elsewhere (generated on first use)", dir)
+		}
+	}
+	if abs, err := filepath.Abs(keyFile); err == nil && filepath.Dir(abs) == dir {
+		legacyKeyFileWarning.Do(func() {
+			fmt.Fprintf(output.Stderr, "warning: key file %s is stored beside the encrypted credentials, so anyone who can read one can decrypt the other; set LINEAR_CREDENTIALS_PASSPHRASE, or move the key file and point LINEAR_CREDENTIALS_KEY_FILE at it\n", keyFile)
+		})
+	}
+
+	data, err := os.ReadFile(keyFile)
+	if err == nil {
+		key := strings.TrimSpace(string(data))
+		if key == "" {
+			return "", "", fmt.Errorf("key file %s is empty", keyFile)
+		}
+		return key, kdfKeyFile, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", "", fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	// Generate the key file on first use
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	key := hex.EncodeToString(raw)
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(keyFile, []byte(key+"\n"), 0600); err != nil {
+		return "", "", fmt.Errorf("failed to write key file: %w", err)
+	}
+	return key, kdfKeyFile, nil
+}
+
+// deriveKey derives the file key with the given KDF. Files written by older
+// versions used PBKDF2 for key files too, so the file's KDF wins.
+func (s *FileStorage) deriveKey(kdf string, salt []byte, iterations int) ([]byte, error) {
+	if s.key != nil && s.kdf == kdf && string(s.salt) == string(salt) {
+		return s.key, nil
+	}
+
+	secret, _, err := s.secret()
+	if err != nil {
+		return nil, err
+	}
+
+	var key []byte
+	if kdf == kdfKeyFile {
+		sum := sha256.Sum256(append(append([]byte{}, salt...), secret...))
+		key = sum[:]
+	} else {
+		key, err = pbkdf2.Key(sha256.New, secret, salt, iterations, 32)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.kdf, s.salt, s.key = kdf, salt, key
+	return key, nil
+}
+
+// load decrypts the credentials file; a missing file is an empty store
+func (s *FileStorage) load() (map[string]string, *encryptedFile, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var file encryptedFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, nil, fmt.Errorf("corrupt credentials file %s: %w", s.path, err)
+	}
+
+	if file.KDF == "" {
+		file.KDF = kdfPBKDF2
+	}
+	key, err := s.deriveKey(file.KDF, file.Salt, file.Iterations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, nil, errors.New("failed to decrypt credentials file: wrong passphrase or key file")
+	}
+
+	values := map[string]string{}
+	if err := json.Unmarshal(plaintext, &values); err != nil {
+		return nil, nil, err
+	}
+
+	// Re-encrypt files from older versions that stretched a key file with
+	// PBKDF2, so later runs skip the slow KDF
+	if _, kdf, err := s.secret(); err == nil && kdf != file.KDF {
+		if err := s.save(values, &file); err == nil {
+			return s.load()
+		}
+	}
+
+	return values, &file, nil
+}
+
+// save encrypts and atomically writes the credentials file with the KDF
+// that suits the current secret, reusing the existing salt so the derived
+// key stays cached
+func (s *FileStorage) save(values map[string]string, previous *encryptedFile) error {
+	_, kdf, err := s.secret()
+	if err != nil {
+		return err
+	}
+
+	file := encryptedFile{
+		Version: 1,
+		KDF:     kdf,
+	}
+	if kdf == kdfPBKDF2 {
+		file.Iterations = pbkdf2Iterations
+	}
+	if previous != nil {
+		file.Salt = previous.Salt
+		if kdf == previous.KDF {
+			file.Iterations = previous.Iterations
+		}
+	} else {
+		file.Salt = make([]byte, 16)
+		if _, err := rand.Read(file.Salt); err != nil {
+			return err
+		}
+	}
+
+	key, err := s.deriveKey(file.KDF, file.Salt, file.Iterations)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *FileStorage) get(name string) (string, error) {
+	values, _, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	if v, ok := values[name]; ok {
+		return v, nil
+	}
+	return "", keyring.ErrNotFound
+}
+
+func (s *FileStorage) set(name, value string) error {
+	values, file, err := s.load()
+	if err != nil {
+		return err
+	}
+	values[name] = value
+	return s.save(values, file)
+}
+
+func (s *FileStorage) delete(name string) error {
+	values, file, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := values[name]; !ok {
+		return nil
+	}
+	delete(values, name)
+	return s.save(values, file)
+}
+
+// GetAPIKey retrieves the stored API key
+func (s *FileStorage) GetAPIKey() (string, error) {
+	return s.get(keyAPIKey)
+}
+
+// SetAPIKey stores an API key
+func (s *FileStorage) SetAPIKey(key string) error {
+	return s.set(keyAPIKey, key)
+}
+
+// DeleteAPIKey removes the stored API key
+func (s *FileStorage) DeleteAPIKey() error {
+	return s.delete(keyAPIKey)
+}
+
+// GetTokenInfo retrieves stored OAuth token info
+func (s *FileStorage) GetTokenInfo() (*TokenInfo, error) {
+	data, err := s.get(keyTokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var info TokenInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// SetTokenInfo stores OAuth token info
+func (s *FileStorage) SetTokenInfo(info *TokenInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return s.set(keyTokenInfo, string(data))
+}
+
+// DeleteTokenInfo removes stored OAuth token info
+func (s *FileStorage) DeleteTokenInfo() error {
+	return s.delete(keyTokenInfo)
+}
+
+// GetClientID retrieves the stored client ID
+func (s *FileStorage) GetClientID() (string, error) {
+	return s.get(keyClientID)
+}
+
+// SetClientID stores a client ID
+func (s *FileStorage) SetClientID(id string) error {
+	return s.set(keyClientID, id)
+}
+
+// DeleteClientID removes the stored client ID
+func (s *FileStorage) DeleteClientID() error {
+	return s.delete(keyClientID)
+}
+
+// GetClientSecret retrieves the stored client secret
+func (s *FileStorage) GetClientSecret() (string, error) {
+	return s.get(keyClientSecret)
+}
+
+// SetClientSecret stores a client secret
+func (s *FileStorage) SetClientSecret(secret string) error {
+	return s.set(keyClientSecret, secret)
+}
+
+// DeleteClientSecret removes the stored client secret
+func (s *FileStorage) DeleteClientSecret() error {
+	return s.delete(keyClientSecret)
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+const (
+	// HelperHost is the host attribute sent to credential helpers
+	HelperHost = "api.linear.app"
+
+	// helperCommandFile records the command chosen with 'auth storage helper'
+	helperCommandFile = "credential-helper"
+
+	// helperActiveEnv is set while a helper runs, so 'linear auth helper'
+	// can refuse to recurse into itself
+	helperActiveEnv = "LINEAR_CREDENTIAL_HELPER_ACTIVE"
+)
+
+// HelperStorage implements Storage by shelling out to an external credential
+// helper speaking the git-credential protocol: the helper is invoked as
+// "<command> get|store|erase" with key=value attributes on stdin, and
+// answers get with key=value attributes on stdout.
+//
+// Each stored item is addressed as protocol=https, host=api.linear.app,
+// username=<item> (api_key, token_info, client_id, client_secret).
+type HelperStorage struct {
+	command string
+}
+
+// NewHelperStorage creates a helper-backed storage using the command from
+// LINEAR_CREDENTIAL_HELPER or the one saved with 'linear auth storage'
+func NewHelperStorage() (*HelperStorage, error) {
+	command := HelperCommand()
+	if command == "" {
+		return nil, fmt.Errorf("no credential helper configured: set LINEAR_CREDENTIAL_HELPER or run 'linear auth storage helper --command <cmd>'")
+	}
+	return &HelperStorage{command: command}, nil
+}
+
+// HelperCommand returns the configured credential helper command
+func HelperCommand() string {
+	if command := os.Getenv("LINEAR_CREDENTIAL_HELPER"); command != "" {
+		return command
+	}
+	dir, err := ConfigDir()
+	if err != nil {
+		return ""
+	}
+	data, err := os.ReadFile(filepath.Join(dir, helperCommandFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// SetHelperCommand saves the credential helper command
+func SetHelperCommand(command string) error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, helperCommandFile), []byte(command+"\n"), 0600)
+}
+
+// HelperActive reports whether the current process was started by a
+// credential helper invocation
+func HelperActive() bool {
+	return os.Getenv(helperActiveEnv) != ""
+}
+
+// ReadCredentialAttributes parses git-credential key=value lines up to a
+// blank line or EOF
+func ReadCredentialAttributes(r io.Reader) (map[string]string, error) {
+	attrs := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed credential attribute: %q", line)
+		}
+		attrs[key] = value
+	}
+	return attrs, scanner.Err()
+}
+
+// WriteCredentialAttributes writes key=value lines in a stable order
+func WriteCredentialAttributes(w io.Writer, attrs map[string]string) error {
+	for _, key := range []string{"protocol", "host", "path", "username", "password"} {
+		if value, ok := attrs[key]; ok {
+			if strings.ContainsAny(value, "\n\x00") {
+				return fmt.Errorf("credential attribute %s contains a newline", key)
+			}
+			if _, err := fmt.Fprintf(w, "%s=%s\n", key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// run invokes the helper with an action and the item's attributes
+func (s *HelperStorage) run(action, name, value string) (map[string]string, error) {
+	attrs := map[string]string{
+		"protocol": "https",
+		"host":     HelperHost,
+		"username": name,
+	}
+	if value != "" {
+		attrs["password"] = value
+	}
+
+	var stdin, stdout, stderr bytes.Buffer
+	if err := WriteCredentialAttributes(&stdin, attrs); err != nil {
+		return nil, err
+	}
+	stdin.WriteString("\n")
+
+	c := exec.Command("sh", "-c", s.command+" "+action)
+	c.Stdin = &stdin
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	c.Env = append(os.Environ(), helperActiveEnv+"=1")
+
+	if err := c.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("credential helper %s failed: %s", action, message)
+	}
+
+	if action != "get" {
+		return nil, nil
+	}
+	return ReadCredentialAttributes(&stdout)
+}
+
+func (s *HelperStorage) get(name string) (string, error) {
+	attrs, err := s.run("get", name, "")
+	if err != nil {
+		return "", err
+	}
+	if attrs["password"] == "" {
+		return "", keyring.ErrNotFound
+	}
+	return attrs["password"], nil
+}
+
+func (s *HelperStorage) set(name, value string) error {
+	_, err := s.run("store", name, value)
+	return err
+}
+
+func (s *HelperStorage) delete(name string) error {
+	_, err := s.run("erase", name, "")
+	return err
+}
+
+// GetAPIKey retrieves the stored API key
+func (s *HelperStorage) GetAPIKey() (string, error) {
+	return s.get(keyAPIKey)
+}
+
+// SetAPIKey stores an API key
+func (s *HelperStorage) SetAPIKey(key string) error {
+	return s.set(keyAPIKey, key)
+}
+
+// DeleteAPIKey removes the stored API key
+func (s *HelperStorage) DeleteAPIKey() error {
+	return s.delete(keyAPIKey)
+}
+
+// GetTokenInfo retrieves stored OAuth token info
+func (s *HelperStorage) GetTokenInfo() (*TokenInfo, error) {
+	data, err := s.get(keyTokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var info TokenInfo
+	if err := json.Unmarshal([]byte(data), &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// SetTokenInfo stores OAuth token info
+func (s *HelperStorage) SetTokenInfo(info *TokenInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return s.set(keyTokenInfo, string(data))
+}
+
+// DeleteTokenInfo removes stored OAuth token info
+func (s *HelperStorage) DeleteTokenInfo() error {
+	return s.delete(keyTokenInfo)
+}
+
+// GetClientID retrieves the stored client ID
+func (s *HelperStorage) GetClientID() (string, error) {
+	return s.get(keyClientID)
+}
+
+// SetClientID stores a client ID
+func (s *HelperStorage) SetClientID(id string) error {
+	return s.set(keyClientID, id)
+}
+
+// DeleteClientID removes the stored client ID
+func (s *HelperStorage) DeleteClientID() error {
+	return s.delete(keyClientID)
+}
+
+// GetClientSecret retrieves the stored client secret
+func (s *HelperStorage) GetClientSecret() (string, error) {
+	return s.get(keyClientSecret)
+}
+
+// SetClientSecret stores a client secret
+func (s *HelperStorage) SetClientSecret(secret string) error {
+	return s.set(keyClientSecret, secret)
+}
+
+// DeleteClientSecret removes the stored client secret
+func (s *HelperStorage) DeleteClientSecret() error {
+	return s.delete(keyClientSecret)
+}
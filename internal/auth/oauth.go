@@ -0,0 +1,278 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+const (
+	// LinearAuthorizeEndpoint is the OAuth authorization endpoint
+	LinearAuthorizeEndpoint = "https://linear.app/oauth/authorize"
+
+	// DefaultOAuthScopes are requested by browser login unless overridden
+	DefaultOAuthScopes = "read,write"
+
+	// DefaultCallbackPort is the loopback port for the OAuth redirect.
+	// The redirect URI http://127.0.0.1:<port>/callback must be registered
+	// on the OAuth application.
+	DefaultCallbackPort = 8976
+
+	// callbackPath is the loopback redirect path
+	callbackPath = "/callback"
+
+	// tokenRequestTimeout bounds a single OAuth token request
+	tokenRequestTimeout = 30 * time.Second
+)
+
+// WebLoginOptions configures the authorization code flow
+type WebLoginOptions struct {
+	ClientID string
+	Scopes   string
+	Port     int
+
+	// OpenURL is called with the authorization URL, typically to launch a
+	// browser. Login proceeds even if it returns an error, so the caller
+	// should also show the URL.
+	OpenURL func(authURL string) error
+}
+
+// pkce holds a PKCE code verifier and its S256 challenge
+type pkce struct {
+	verifier  string
+	challenge string
+}
+
+// newPKCE generates a random code verifier (RFC 7636)
+func newPKCE() (*pkce, error) {
+	verifier, err := randomURLString(32)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return &pkce{
+		verifier:  verifier,
+		challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+func randomURLString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// LoginWithBrowser runs the OAuth authorization code flow with PKCE using a
+// loopback redirect listener, then stores the access and refresh tokens
+func (m *Manager) LoginWithBrowser(ctx context.Context, opts WebLoginOptions) (*TokenInfo, error) {
+	if opts.ClientID == "" {
+		opts.ClientID = DefaultClientID
+	}
+	if opts.Scopes == "" {
+		opts.Scopes = DefaultOAuthScopes
+	}
+	if opts.Port == 0 {
+		opts.Port = DefaultCallbackPort
+	}
+
+	challenge, err := newPKCE()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomURLString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(opts.Port)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start callback listener: %w", err)
+	}
+	redirectURI := fmt.Sprintf("http://127.0.0.1:%d%s", opts.Port, callbackPath)
+
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		result := callbackResult{code: query.Get("code")}
+
+		// A request without our state isn't the answer to this login, e.g.
+		// a stale tab or another page probing the port, so keep waiting
+		if query.Get("state") != state {
+			http.Error(w, "state mismatch in OAuth callback", http.StatusBadRequest)
+			return
+		}
+
+		switch {
+		case query.Get("error") != "":
+			result.err = fmt.Errorf("authorization denied: %s", query.Get("error"))
+		case result.code == "":
+			result.err = errors.New("no authorization code in OAuth callback")
+		}
+
+		if result.err != nil {
+			http.Error(w, result.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "Authentication complete. You can close this window and return to the terminal.")
+		}
+
+		select {
+		case results <- result:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+
+	authURL := LinearAuthorizeEndpoint + "?" + url.Values{
+		"client_id":             {opts.ClientID},
+		"redirect_uri":          {redirectURI},
+		"response_type":         {"code"},
+		"scope":                 {opts.Scopes},
+		"state":                 {state},
+		"code_challenge":        {challenge.challenge},
+		"code_challenge_method": {"S256"},
+		"prompt":                {"consent"},
+	}.Encode()
+
+	if opts.OpenURL != nil {
+		opts.OpenURL(authURL)
+	}
+
+	var result callbackResult
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result = <-results:
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	token, err := requestToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {result.code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {opts.ClientID},
+		"code_verifier": {challenge.verifier},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("token exchange failed: %w", err)
+	}
+
+	// A stored API key or client secret would take precedence over (or
+	// replace) the browser token, so clear them
+	if err := m.storage.DeleteAPIKey(); err != nil {
+		return nil, err
+	}
+	if err := m.storage.DeleteClientSecret(); err != nil {
+		return nil, err
+	}
+	if err := m.storage.SetClientID(opts.ClientID); err != nil {
+		return nil, err
+	}
+	if err := m.storage.SetTokenInfo(token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// refreshAccessToken exchanges a refresh token for a new access token and
+// stores it. Linear may rotate refresh tokens; the old one is kept if the
+// response does not include a new one.
+func (m *Manager) refreshAccessToken(ctx context.Context, clientID, refreshToken string) (string, error) {
+	token, err := requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {clientID},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	if err := m.storage.SetTokenInfo(token); err != nil {
+		// Non-fatal: the new token is still usable for this run
+		fmt.Fprintf(output.Stderr, "warning: failed to cache token: %v\n", err)
+	}
+
+	return token.AccessToken, nil
+}
+
+// requestToken posts a form to the token endpoint and returns the token
+// with its absolute expiry and grant filled in
+func requestToken(ctx context.Context, data url.Values) (*TokenInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", LinearTokenEndpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client, err := config.HTTPClient(tokenRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		json.NewDecoder(resp.Body).Decode(&errResp)
+		if errResp.ErrorDescription != "" {
+			return nil, fmt.Errorf("%s: %s", errResp.Error, errResp.ErrorDescription)
+		}
+		if errResp.Error != "" {
+			return nil, errors.New(errResp.Error)
+		}
+		return nil, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	var token TokenInfo
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	// Calculate expiry time
+	token.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	// Record the grant so the method doesn't have to be inferred later
+	token.Grant = AuthMethodOAuth
+	if data.Get("grant_type") == "client_credentials" {
+		token.Grant = AuthMethodClientCredentials
+	}
+
+	return &token, nil
+}
@@ -3,15 +3,21 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
+	"runtime"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/auth"
 	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
@@ -21,11 +27,12 @@ func NewAuthCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "auth",
 		Short: "Manage authentication",
-		Long: `Authenticate with Linear using API keys or OAuth client credentials.
+		Long: `Authenticate with Linear using API keys, browser login, or OAuth client credentials.
 
 Authentication methods (in priority order):
   1. Environment variables: LINEAR_API_KEY or LINEAR_CLIENT_ID + LINEAR_CLIENT_SECRET
-  2. System keychain (secure storage)
+  2. Stored credentials: system keychain, or an encrypted file when no
+     keychain is available (see 'linear auth storage')
   3. Config file (legacy fallback)
 
 Examples:
@@ -34,7 +41,7 @@ Examples:
   linear auth logout             # Remove stored credentials`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Running "linear auth" without subcommand triggers interactive login
-			return runInteractiveAuth()
+			return runInteractiveAuth(cmd.Context())
 		},
 	}
 
@@ -42,14 +49,15 @@ Examples:
 	cmd.AddCommand(newAuthStatusCmd())
 	cmd.AddCommand(newAuthLogoutCmd())
 	cmd.AddCommand(newAuthTokenCmd())
+	cmd.AddCommand(newAuthStorageCmd())
+	cmd.AddCommand(newAuthHelperCmd())
 
 	return cmd
 }
 
 // runInteractiveAuth prompts the user to choose an auth method
-func runInteractiveAuth() error {
+func runInteractiveAuth(ctx context.Context) error {
 	manager := auth.NewManager()
-	ctx := context.Background()
 
 	fmt.Println("Linear CLI Authentication")
 	fmt.Println()
@@ -84,6 +92,10 @@ func newAuthLoginCmd() *cobra.Command {
 	var (
 		withToken         bool
 		clientCredentials bool
+		web               bool
+		clientID          string
+		scopes            string
+		port              int
 		stdin             bool
 		teamKey           string
 	)
@@ -91,11 +103,16 @@ func newAuthLoginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Authenticate with Linear",
-		Long: `Authenticate with Linear using an API key or client credentials.
+		Long: `Authenticate with Linear using an API key, browser login, or client credentials.
 
 API Key (personal use):
   Get your API key from: https://linear.app/settings/api
 
+Browser login (OAuth with PKCE):
+  Opens Linear in your browser and receives the authorization on
+  http://127.0.0.1:<port>/callback. Access and refresh tokens are stored
+  in credential storage and refreshed automatically.
+
 Client Credentials (for agents/automation):
   Create an OAuth app at: https://linear.app/settings/api
   Enable "Client credentials" grant type
@@ -104,14 +121,22 @@ Examples:
   linear auth login                           # Interactive prompt
   linear auth login --with-token              # Paste API key
   linear auth login --with-token --team ENG   # Set up with default team
+  linear auth login --web                     # Log in through the browser
+  linear auth login --web --client-id abc     # Browser login with your own OAuth app
   linear auth login --client-credentials      # Set up OAuth client credentials
   echo $TOKEN | linear auth login --stdin     # Read from stdin (for scripts)`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			manager := auth.NewManager()
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			var err error
-			if clientCredentials {
+			if web {
+				err = loginWithBrowser(ctx, manager, auth.WebLoginOptions{
+					ClientID: clientID,
+					Scopes:   scopes,
+					Port:     port,
+				})
+			} else if clientCredentials {
 				err = loginWithClientCredentials(ctx, manager, stdin)
 			} else {
 				err = loginWithAPIKey(manager, withToken, stdin)
@@ -128,6 +153,10 @@ Examples:
 
 	cmd.Flags().BoolVar(&withToken, "with-token", false, "Read API key from prompt or stdin")
 	cmd.Flags().BoolVar(&clientCredentials, "client-credentials", false, "Set up OAuth client credentials")
+	cmd.Flags().BoolVar(&web, "web", false, "Log in through the browser (OAuth authorization code + PKCE)")
+	cmd.Flags().StringVar(&clientID, "client-id", "", "OAuth client ID for --web (default: built-in app)")
+	cmd.Flags().StringVar(&scopes, "scopes", auth.DefaultOAuthScopes, "OAuth scopes for --web (comma-separated)")
+	cmd.Flags().IntVar(&port, "port", auth.DefaultCallbackPort, "Loopback callback port for --web")
 	cmd.Flags().BoolVar(&stdin, "stdin", false, "Read credentials from stdin (non-interactive)")
 	cmd.Flags().StringVar(&teamKey, "team", "", "Set default team key (e.g., ENG)")
 
@@ -180,12 +209,12 @@ func loginWithAPIKey(manager *auth.Manager, withToken, stdin bool) error {
 
 	if IsHumanOutput() {
 		color.Green("✓ Authentication successful")
-		fmt.Println("  Token stored securely in system keychain")
+		fmt.Println("  Token stored securely in " + manager.StorageLabel())
 	} else {
 		OutputJSON(map[string]interface{}{
 			"success": true,
 			"method":  "api_key",
-			"storage": "keychain",
+			"storage": manager.StorageName(),
 		})
 	}
 
@@ -243,39 +272,129 @@ func loginWithClientCredentials(ctx context.Context, manager *auth.Manager, stdi
 
 	if IsHumanOutput() {
 		color.Green("✓ Authentication successful")
-		fmt.Println("  Credentials stored securely in system keychain")
+		fmt.Println("  Credentials stored securely in " + manager.StorageLabel())
 		fmt.Println("  Token will auto-refresh every 30 days")
 	} else {
 		OutputJSON(map[string]interface{}{
 			"success": true,
 			"method":  "client_credentials",
-			"storage": "keychain",
+			"storage": manager.StorageName(),
+		})
+	}
+
+	return nil
+}
+
+func loginWithBrowser(ctx context.Context, manager *auth.Manager, opts auth.WebLoginOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	opts.OpenURL = func(authURL string) error {
+		fmt.Fprintln(os.Stderr, "Opening Linear in your browser to authorize the CLI.")
+		fmt.Fprintln(os.Stderr, "If it does not open, visit:")
+		fmt.Fprintln(os.Stderr, "  "+authURL)
+		return openBrowser(authURL)
+	}
+
+	token, err := manager.LoginWithBrowser(ctx, opts)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("timed out waiting for browser authorization")
+		}
+		return err
+	}
+
+	if IsHumanOutput() {
+		color.Green("✓ Authentication successful")
+		fmt.Println("  Tokens stored securely in " + manager.StorageLabel())
+		fmt.Printf("  Access token expires: %s (refreshed automatically)\n", token.ExpiresAt.Format("2006-01-02 15:04:05"))
+	} else {
+		OutputJSON(map[string]interface{}{
+			"success":   true,
+			"method":    "oauth",
+			"storage":   manager.StorageName(),
+			"scope":     token.Scope,
+			"expiresAt": token.ExpiresAt,
 		})
 	}
 
 	return nil
 }
 
+// openBrowser opens a URL in the default browser
+func openBrowser(url string) error {
+	var c *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("open", url)
+	case "windows":
+		c = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		c = exec.Command("xdg-open", url)
+	}
+	return c.Start()
+}
+
+// AuthVerification is the result of 'auth status --verify'
+type AuthVerification struct {
+	Valid       bool                 `json:"valid"`
+	Method      auth.AuthMethod      `json:"method"`
+	Source      string               `json:"source"`
+	Scopes      []string             `json:"scopes,omitempty"`
+	ScopesKnown bool                 `json:"scopesKnown"`
+	ReadOnly    bool                 `json:"readOnly"`
+	ExpiresAt   *time.Time           `json:"expiresAt,omitempty"`
+	ExpiresIn   string               `json:"expiresIn,omitempty"`
+	Actor       *api.Viewer          `json:"actor,omitempty"`
+	Application *VerifiedApplication `json:"application,omitempty"`
+	Workspace   *api.Organization    `json:"workspace,omitempty"`
+}
+
+// VerifiedApplication describes the OAuth app behind a token
+type VerifiedApplication struct {
+	ClientID string `json:"clientId"`
+	// ActsAsApp is true for client credentials, where the app itself is
+	// the actor rather than a user who authorized it
+	ActsAsApp bool `json:"actsAsApp"`
+}
+
 func newAuthStatusCmd() *cobra.Command {
-	return &cobra.Command{
+	var verify bool
+
+	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show authentication status",
 		Long: `Display current authentication status and method.
 
 Shows:
   - Whether you're authenticated
-  - Authentication method (API key or client credentials)
-  - Token source (environment, keychain, or config file)
-  - Token expiry (for OAuth tokens)`,
+  - Authentication method (API key, browser OAuth, or client credentials)
+  - Token source (environment, keychain, encrypted file, or config file)
+  - Token expiry (for OAuth tokens)
+
+With --verify, the credential is checked against the API and the report
+adds the token's scopes, the acting user or app, the workspace and the
+time until expiry. Read-only tokens are flagged (mutations will be refused
+before they are sent). Failures use distinct error codes:
+  TOKEN_EXPIRED   The token expired and could not be refreshed
+  TOKEN_REVOKED   The token was rejected by Linear (revoked or invalid)
+
+Examples:
+  linear auth status
+  linear auth status --verify`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			manager := auth.NewManager()
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			status, err := manager.GetStatus(ctx)
 			if err != nil {
 				return err
 			}
 
+			if verify && status.Authenticated {
+				return runAuthVerify(ctx, manager, status)
+			}
+
 			if IsHumanOutput() {
 				if status.Authenticated {
 					color.Green("✓ Authenticated")
@@ -297,13 +416,130 @@ Shows:
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&verify, "verify", false, "Check the credential against the API and report scopes, actor and workspace")
+
+	return cmd
+}
+
+// runAuthVerify checks the active credential against the API
+func runAuthVerify(ctx context.Context, manager *auth.Manager, status *auth.AuthStatus) error {
+	var tokenInfo *auth.TokenInfo
+	if status.Method != auth.AuthMethodAPIKey {
+		tokenInfo, _ = manager.StoredTokenInfo()
+	}
+
+	token, method, err := manager.GetToken(ctx)
+	if err != nil {
+		code := "AUTH_ERROR"
+		message := err.Error()
+		switch {
+		case strings.Contains(message, "invalid_grant") || strings.Contains(message, "revoked"):
+			code = "TOKEN_REVOKED"
+		case strings.Contains(message, "token refresh failed"):
+			code = "TOKEN_EXPIRED"
+		}
+		return reportAuthVerifyError(code, message)
+	}
+
+	// GetToken may have refreshed the token
+	if method != auth.AuthMethodAPIKey {
+		tokenInfo, _ = manager.StoredTokenInfo()
+		if tokenInfo != nil && tokenInfo.AccessToken != token {
+			tokenInfo = nil
+		}
+	}
+
+	viewer, err := api.NewClientWithToken(token).GetViewer(ctx)
+	if err != nil {
+		if !isAuthRejection(err) {
+			return reportAuthVerifyError("API_ERROR", err.Error())
+		}
+		if tokenInfo != nil && time.Now().After(tokenInfo.ExpiresAt) {
+			return reportAuthVerifyError("TOKEN_EXPIRED", "token expired at "+tokenInfo.ExpiresAt.Format(time.RFC3339))
+		}
+		return reportAuthVerifyError("TOKEN_REVOKED", "token was rejected by Linear; it may have been revoked: "+err.Error())
+	}
+
+	result := &AuthVerification{
+		Valid:     true,
+		Method:    method,
+		Source:    status.Source,
+		Actor:     &viewer.Viewer,
+		Workspace: &viewer.Organization,
+	}
+
+	if tokenInfo != nil {
+		result.Scopes = tokenInfo.Scopes()
+		result.ScopesKnown = len(result.Scopes) > 0
+		result.ReadOnly = auth.ReadOnlyScopes(result.Scopes)
+		result.ExpiresAt = &tokenInfo.ExpiresAt
+		result.ExpiresIn = time.Until(tokenInfo.ExpiresAt).Round(time.Minute).String()
+
+		clientID := os.Getenv("LINEAR_CLIENT_ID")
+		if clientID == "" {
+			clientID = manager.StoredClientID()
+		}
+		if clientID != "" {
+			result.Application = &VerifiedApplication{
+				ClientID:  clientID,
+				ActsAsApp: method == auth.AuthMethodClientCredentials,
+			}
+		}
+	}
+
+	if IsHumanOutput() {
+		color.Green("✓ Credential verified")
+		fmt.Printf("  Method: %s\n", result.Method)
+		fmt.Printf("  Source: %s\n", result.Source)
+		fmt.Printf("  Actor: %s (%s)\n", result.Actor.DisplayName, result.Actor.Email)
+		fmt.Printf("  Workspace: %s (%s)\n", result.Workspace.Name, result.Workspace.UrlKey)
+		if result.Application != nil {
+			fmt.Printf("  Application: %s\n", result.Application.ClientID)
+		}
+		if result.ScopesKnown {
+			fmt.Printf("  Scopes: %s\n", strings.Join(result.Scopes, ", "))
+		} else {
+			fmt.Println("  Scopes: unknown (API keys do not report scopes)")
+		}
+		if result.ExpiresAt != nil {
+			fmt.Printf("  Expires: %s (in %s)\n", result.ExpiresAt.Format("2006-01-02 15:04:05"), result.ExpiresIn)
+		}
+		if result.ReadOnly {
+			color.Yellow("  Read-only token: create, update and delete commands will be refused")
+		}
+	} else {
+		OutputJSON(result)
+	}
+
+	return nil
+}
+
+// isAuthRejection reports whether an API error means the credential was
+// not accepted
+func isAuthRejection(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, marker := range []string{"authentication", "unauthorized", "401", "invalid api key", "not authenticated"} {
+		if strings.Contains(message, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func reportAuthVerifyError(code, message string) error {
+	if IsHumanOutput() {
+		output.ErrorHuman(fmt.Sprintf("%s: %s", code, message))
+		return nil
+	}
+	return output.Error(code, message)
 }
 
 func newAuthLogoutCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "logout",
 		Short: "Remove stored credentials",
-		Long: `Remove all stored credentials from the system keychain.
+		Long: `Remove all stored credentials from the active credential storage.
 
 Note: This does not affect environment variables.
 To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_SECRET.`,
@@ -316,7 +552,7 @@ To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_
 
 			if IsHumanOutput() {
 				color.Green("✓ Logged out")
-				fmt.Println("  Credentials removed from keychain")
+				fmt.Println("  Credentials removed from " + manager.StorageLabel())
 
 				// Warn about environment variables
 				if os.Getenv("LINEAR_API_KEY") != "" {
@@ -328,7 +564,7 @@ To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_
 			} else {
 				OutputJSON(map[string]interface{}{
 					"success": true,
-					"message": "credentials removed from keychain",
+					"message": "credentials removed from " + manager.StorageLabel(),
 				})
 			}
 
@@ -338,7 +574,9 @@ To fully logout, also unset LINEAR_API_KEY, LINEAR_CLIENT_ID, and LINEAR_CLIENT_
 }
 
 func newAuthTokenCmd() *cobra.Command {
-	return &cobra.Command{
+	var reveal bool
+
+	cmd := &cobra.Command{
 		Use:   "token",
 		Short: "Print current access token",
 		Long: `Print the current access token to stdout.
@@ -346,22 +584,232 @@ func newAuthTokenCmd() *cobra.Command {
 Useful for piping to other commands or debugging.
 The token is printed without a trailing newline.
 
-Example:
-  curl -H "Authorization: $(linear auth token)" https://api.linear.app/graphql`,
+When stdout is a terminal the token is only printed with --reveal, so it
+doesn't end up in scrollback or screen recordings by accident. Piped
+output is never masked.
+
+Examples:
+  curl -H "Authorization: $(linear auth token)" https://api.linear.app/graphql
+  linear auth token --reveal`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if !reveal && term.IsTerminal(int(os.Stdout.Fd())) {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"Refusing to print the access token to a terminal",
+						"Pass --reveal to print it anyway, or pipe the output to another command",
+						"linear auth token --reveal",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"REVEAL_REQUIRED",
+					"Refusing to print the access token to a terminal",
+					"Pass --reveal to print it anyway, or pipe the output to another command",
+					"linear auth token --reveal",
+					`curl -H "Authorization: $(linear auth token)" https://api.linear.app/graphql`,
+				)
+			}
+
 			manager := auth.NewManager()
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			token, _, err := manager.GetToken(ctx)
 			if err != nil {
 				return err
 			}
 
-			// Print token without newline for piping
+			// Print token without newline for piping; this is the one path
+			// that deliberately bypasses redaction
 			fmt.Print(token)
 			return nil
 		},
 	}
+
+	cmd.Flags().BoolVar(&reveal, "reveal", false, "Print the token even when stdout is a terminal")
+
+	return cmd
+}
+
+func newAuthStorageCmd() *cobra.Command {
+	var (
+		noMigrate     bool
+		helperCommand string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "storage [keyring|file|helper]",
+		Short: "Show or switch the credential storage backend",
+		Long: `Show or switch where stored credentials are kept.
+
+Backends:
+  keyring  System keychain (macOS Keychain, Windows Credential Manager,
+           Secret Service on Linux)
+  file     AES-GCM encrypted file in the config directory, for containers,
+           CI runners and headless Linux without a Secret Service
+  helper   External credential helper speaking the git-credential protocol
+           (e.g. a Vault or 1Password wrapper), set with --command or
+           LINEAR_CREDENTIAL_HELPER
+
+When no backend is chosen, the keyring is used if available, otherwise the
+encrypted file. LINEAR_CREDENTIAL_STORAGE overrides the saved choice.
+
+The file key is derived from LINEAR_CREDENTIALS_PASSPHRASE, or from a key
+file named by LINEAR_CREDENTIALS_KEY_FILE (generated on first use). One of
+them is required, and the key file must live outside the config directory:
+a key stored beside the file it protects only obfuscates it. A
+credentials.key left next to the file by older versions is still read, with
+a warning.
+
+Switching moves existing credentials to the new backend unless
+--no-migrate is given.
+
+Examples:
+  linear auth storage              # Show the active backend
+  linear auth storage file         # Switch to the encrypted file
+  linear auth storage keyring      # Switch back to the keychain
+  linear auth storage helper --command "op-linear-helper"`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager := auth.NewManager()
+			current := manager.StorageSelection()
+
+			if len(args) == 0 {
+				if IsHumanOutput() {
+					fmt.Printf("Backend: %s (%s)\n", current.Backend, current.Source)
+					fmt.Printf("  Keyring available: %s\n", display.BoolToYesNo(current.KeyringAvailable))
+					if current.FilePath != "" {
+						fmt.Printf("  Credentials file: %s\n", current.FilePath)
+					}
+				} else {
+					OutputJSON(current)
+				}
+				return nil
+			}
+
+			backend := args[0]
+			if helperCommand != "" {
+				if err := auth.SetHelperCommand(helperCommand); err != nil {
+					return err
+				}
+			}
+			if backend == auth.StorageHelper && auth.HelperCommand() == "" {
+				return fmt.Errorf("no credential helper configured: pass --command or set LINEAR_CREDENTIAL_HELPER")
+			}
+
+			// Migrate before saving the preference, so a backend that can't
+			// store the credentials doesn't leave them behind the old one
+			moved := 0
+			if !noMigrate && backend != current.Backend {
+				from, err := auth.NewStorage(current.Backend)
+				if err != nil {
+					return err
+				}
+				to, err := auth.NewStorage(backend)
+				if err != nil {
+					return err
+				}
+				moved, err = auth.MigrateStorage(from, to)
+				if err != nil {
+					return fmt.Errorf("failed to migrate credentials, storage is still %s: %w", current.Backend, err)
+				}
+			}
+
+			if err := auth.SetStoragePreference(backend); err != nil {
+				return err
+			}
+
+			if os.Getenv("LINEAR_CREDENTIAL_STORAGE") != "" && IsHumanOutput() {
+				color.Yellow("  Note: LINEAR_CREDENTIAL_STORAGE is set and overrides this choice")
+			}
+
+			if IsHumanOutput() {
+				color.Green("✓ Credential storage set to %s", backend)
+				if moved > 0 {
+					fmt.Printf("  Moved %d stored credentials from %s\n", moved, current.Backend)
+				}
+			} else {
+				OutputJSON(map[string]interface{}{
+					"success":  true,
+					"backend":  backend,
+					"previous": current.Backend,
+					"migrated": moved,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&noMigrate, "no-migrate", false, "Do not move existing credentials to the new backend")
+	cmd.Flags().StringVar(&helperCommand, "command", "", "Credential helper command for the helper backend")
+
+	return cmd
+}
+
+func newAuthHelperCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "helper <get|store|erase>",
+		Short: "Act as a git-credential-style helper for other tools",
+		Long: `Speak the git-credential helper protocol so other tools can obtain the
+Linear token from this CLI.
+
+Attributes are read from stdin as key=value lines ending with a blank line.
+Only requests for host=api.linear.app are answered.
+
+  get    Prints the current access token as password=<token>
+  store  Stores a personal API key (password=lin_api_...)
+  erase  Removes the stored API key or access token matching password=;
+         other credentials are kept, and a request without a matching
+         password does nothing
+
+Examples:
+  printf 'protocol=https\nhost=api.linear.app\n\n' | linear auth helper get
+  git config --global credential.https://api.linear.app.helper "!linear auth helper"`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"get", "store", "erase"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if auth.HelperActive() {
+				return fmt.Errorf("'linear auth helper' cannot be used as the CLI's own credential helper")
+			}
+
+			attrs, err := auth.ReadCredentialAttributes(os.Stdin)
+			if err != nil {
+				return err
+			}
+
+			// Like git helpers, silently ignore requests for other hosts
+			if host := attrs["host"]; host != "" && host != auth.HelperHost {
+				return nil
+			}
+
+			manager := auth.NewManager()
+			ctx := cmd.Context()
+
+			switch args[0] {
+			case "get":
+				token, _, err := manager.GetToken(ctx)
+				if err != nil {
+					return err
+				}
+				return auth.WriteCredentialAttributes(os.Stdout, map[string]string{
+					"protocol": "https",
+					"host":     auth.HelperHost,
+					"username": "linear",
+					"password": token,
+				})
+			case "store":
+				if password := attrs["password"]; strings.HasPrefix(password, "lin_api_") {
+					return manager.LoginWithAPIKey(password)
+				}
+				return nil
+			case "erase":
+				_, err := manager.EraseCredential(attrs["password"])
+				return err
+			default:
+				return fmt.Errorf("unknown helper action: %s (expected get, store or erase)", args[0])
+			}
+		},
+	}
 }
 
 // handlePostAuthTeamSetup sets up team config after successful authentication
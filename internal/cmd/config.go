@@ -20,6 +20,11 @@ var validConfigKeys = []string{
 	"api_key",
 	"team_id",
 	"team_key",
+	"proxy_url",
+	"ca_bundle",
+	"client_cert",
+	"client_key",
+	"tls_min_version",
 }
 
 // NewConfigCmd creates the config command group
@@ -36,6 +41,13 @@ Available keys:
   team_id   - Default team ID
   team_key  - Default team key (e.g., ENG)
 
+Network keys (env overrides in parentheses):
+  proxy_url        - HTTP(S) proxy URL (LINEAR_PROXY_URL; default HTTPS_PROXY)
+  ca_bundle        - PEM file of extra trusted CAs (LINEAR_CA_BUNDLE)
+  client_cert      - PEM client certificate for mTLS (LINEAR_CLIENT_CERT)
+  client_key       - PEM private key for client_cert (LINEAR_CLIENT_KEY)
+  tls_min_version  - Minimum TLS version: 1.2 or 1.3 (LINEAR_TLS_MIN_VERSION)
+
 Examples:
   linear config list
   linear config get team_key
@@ -61,6 +73,7 @@ Available keys:
   api_key   - Linear API key
   team_id   - Default team ID
   team_key  - Default team key
+  proxy_url, ca_bundle, client_cert, client_key, tls_min_version
 
 Examples:
   linear config get team_key
@@ -87,6 +100,11 @@ Examples:
 				return output.Error("CONFIG_ERROR", err.Error())
 			}
 
+			// Never print the proxy password
+			if key == "proxy_url" {
+				value = config.RedactProxyURL(value)
+			}
+
 			if IsHumanOutput() {
 				if value == "" {
 					output.HumanLn("%s: %s", key, output.Muted("(not set)"))
@@ -121,10 +139,13 @@ Available keys:
   api_key   - Linear API key (prefer using 'linear auth' instead)
   team_id   - Default team ID
   team_key  - Default team key (e.g., ENG)
+  proxy_url, ca_bundle, client_cert, client_key, tls_min_version
 
 Examples:
   linear config set team_key ENG
-  linear config set team_id abc123`,
+  linear config set team_id abc123
+  linear config set proxy_url http://proxy.corp:3128
+  linear config set ca_bundle /etc/ssl/corp-ca.pem`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			key := args[0]
@@ -235,6 +256,20 @@ Examples:
 					output.HumanLn("  team_key: %s", output.Muted("(not set)"))
 				}
 
+				// Network settings (only when configured)
+				network := cfg.Network().Redacted()
+				for _, kv := range [][2]string{
+					{"proxy_url", network.ProxyURL},
+					{"ca_bundle", network.CABundle},
+					{"client_cert", network.ClientCert},
+					{"client_key", network.ClientKey},
+					{"tls_min_version", network.TLSMinVersion},
+				} {
+					if kv[1] != "" {
+						output.HumanLn("  %s: %s", kv[0], kv[1])
+					}
+				}
+
 				// Environment variable hints
 				output.HumanLn("")
 				output.HumanLn("Environment variables:")
@@ -247,6 +282,7 @@ Examples:
 					"api_key":  cfg.APIKey,
 					"team_id":  cfg.TeamID,
 					"team_key": cfg.TeamKey,
+					"network":  cfg.Network().Redacted(),
 				}
 
 				envVars := map[string]string{}
@@ -329,7 +365,7 @@ Examples:
   linear config setup --validate
   echo "lin_api_xxx" | linear config setup --stdin --team ENG`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			// If validate flag is set, just validate existing config
 			if validate {
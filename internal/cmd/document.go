@@ -2,11 +2,18 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/docfile"
 	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
@@ -22,7 +29,10 @@ func NewDocumentCmd() *cobra.Command {
 Examples:
   linear document list
   linear document view <document-id>
-  linear document create --title "PRD: Feature X"`,
+  linear document create --title "PRD: Feature X"
+  linear document pull <document-id> -o spec.md
+  linear document push spec.md
+  linear document sync ./docs --project <project-id>`,
 	}
 
 	cmd.AddCommand(newDocumentListCmd())
@@ -32,6 +42,9 @@ Examples:
 	cmd.AddCommand(newDocumentDeleteCmd())
 	cmd.AddCommand(newDocumentRestoreCmd())
 	cmd.AddCommand(newDocumentSearchCmd())
+	cmd.AddCommand(newDocumentPullCmd())
+	cmd.AddCommand(newDocumentPushCmd())
+	cmd.AddCommand(newDocumentSyncCmd())
 
 	return cmd
 }
@@ -52,7 +65,7 @@ Examples:
   linear document list --project abc123
   linear document list --limit 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -100,7 +113,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -180,7 +193,7 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -290,23 +303,35 @@ func newDocumentUpdateCmd() *cobra.Command {
 		projectID string
 		icon      string
 		color     string
+		unset     []string
 	)
 
+	clearable := []string{"project", "icon", "color"}
+
 	cmd := &cobra.Command{
 		Use:   "update <document-id>",
 		Short: "Update a document",
 		Long: `Update an existing document.
 
+Use --unset to clear a field: project (detach from its project), icon, color.
+
 Examples:
   linear document update abc123 --title "New Title"
   linear document update abc123 --content "Updated content..."
-  linear document update abc123 --project xyz789`,
+  linear document update abc123 --project xyz789
+  linear document update abc123 --unset project`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
 
+			cleared, err := resolveUnset(cmd, unset, clearable)
+			if err != nil {
+				return reportUnsetError(err, "linear document update abc123 --unset project")
+			}
+
 			// Check if at least one field is being updated
-			if !cmd.Flags().Changed("title") &&
+			if len(cleared) == 0 &&
+				!cmd.Flags().Changed("title") &&
 				!cmd.Flags().Changed("content") &&
 				!cmd.Flags().Changed("project") &&
 				!cmd.Flags().Changed("icon") &&
@@ -318,7 +343,7 @@ Examples:
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -337,15 +362,9 @@ Examples:
 			if cmd.Flags().Changed("content") {
 				input.Content = content
 			}
-			if cmd.Flags().Changed("project") {
-				input.ProjectID = projectID
-			}
-			if cmd.Flags().Changed("icon") {
-				input.Icon = icon
-			}
-			if cmd.Flags().Changed("color") {
-				input.Color = color
-			}
+			input.ProjectID = nullableField(cleared, "project", projectID)
+			input.Icon = nullableField(cleared, "icon", icon)
+			input.Color = nullableField(cleared, "color", color)
 
 			document, err := client.UpdateDocument(ctx, documentID, input)
 			if err != nil {
@@ -375,6 +394,7 @@ Examples:
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project ID to attach document to")
 	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Document icon")
 	cmd.Flags().StringVar(&color, "color", "", "Document color (#RRGGBB)")
+	addUnsetFlag(cmd, &unset, clearable)
 
 	return cmd
 }
@@ -390,7 +410,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -438,7 +458,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			documentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -489,7 +509,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -524,6 +544,1015 @@ Examples:
 	return cmd
 }
 
+func newDocumentPullCmd() *cobra.Command {
+	var (
+		outputPath string
+		force      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pull <document-id>",
+		Short: "Export a document as Markdown",
+		Long: `Export a document as Markdown with YAML front matter.
+
+The front matter records the document's id, title, project, icon, color,
+and updatedAt. Edit the file and send it back with 'linear document push'.
+
+With -o, an existing file is only replaced if it is a pulled copy of the
+same document without unpushed edits. Pass --force to overwrite it anyway.
+
+Examples:
+  linear document pull abc123
+  linear document pull abc123 -o spec.md
+  linear document pull abc123 -o spec.md --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			documentID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			document, err := client.GetDocument(ctx, documentID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if document == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(fmt.Sprintf("Document '%s' not found", documentID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Document '%s' not found", documentID))
+			}
+
+			file := docfile.File{
+				FrontMatter: documentFrontMatter(document),
+				Content:     document.Content,
+			}
+
+			// Document bodies go out unredacted, so a pulled file round-trips
+			// exactly
+			if outputPath == "" {
+				os.Stdout.Write(docfile.Format(file))
+				return nil
+			}
+
+			if !force {
+				reason, err := pullWouldDiscard(outputPath, document)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("READ_ERROR", err.Error())
+				}
+				if reason != "" {
+					hint := "Push the file first with 'linear document push', or pass --force to overwrite it"
+					example := fmt.Sprintf("linear document pull %s -o %s --force", documentID, outputPath)
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(reason, hint, example)
+						return nil
+					}
+					return output.ErrorWithHint("LOCAL_CHANGES", reason, hint, example)
+				}
+			}
+
+			// Keep front matter keys of other tools from the copy being replaced
+			if data, err := os.ReadFile(outputPath); err == nil {
+				if existing, err := docfile.Parse(data); err == nil && existing.FrontMatter.ID == document.ID {
+					file.Extra = existing.Extra
+				}
+			}
+
+			if err := os.WriteFile(outputPath, docfile.Format(file), 0644); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("WRITE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Pulled %s to %s", document.Title, outputPath))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "pull",
+				"path":      outputPath,
+				"document":  file.FrontMatter,
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write to a file instead of stdout")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Overwrite the file even if it has changes that are not in Linear")
+
+	return cmd
+}
+
+// pullWouldDiscard explains why overwriting path with document could lose
+// local work, or returns "" when the file is missing or already matches
+// its recorded revision
+func pullWouldDiscard(path string, document *api.Document) (string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	local, err := docfile.Parse(data)
+	if err != nil || local.FrontMatter.ID != document.ID {
+		return fmt.Sprintf("%s exists and is not a pulled copy of this document", path), nil
+	}
+
+	if normalizeContent(local.Content) == normalizeContent(document.Content) &&
+		(local.FrontMatter.Title == "" || local.FrontMatter.Title == document.Title) {
+		return "", nil
+	}
+	if local.FrontMatter.UpdatedAt == document.UpdatedAt {
+		return fmt.Sprintf("%s has edits that have not been pushed", path), nil
+	}
+	return fmt.Sprintf("%s differs from the document, which also changed in Linear since the file was pulled; the file may have edits that have not been pushed", path), nil
+}
+
+func newDocumentPushCmd() *cobra.Command {
+	var (
+		teamKey string
+		force   bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "push <file>",
+		Short: "Create or update a document from Markdown",
+		Long: `Create or update a document from a Markdown file.
+
+If the front matter has an id the document is updated, otherwise a new
+document is created. The title comes from the front matter, then the first
+"# " heading, then the file name. New documents are attached to the
+front-matter project, or to --team (default: configured team).
+
+Updates are refused if the document changed in Linear since the file was
+pulled (its updatedAt no longer matches). Pull again, or pass --force to
+overwrite the remote changes.
+
+After a push the file's front matter is rewritten with the document id and
+new updatedAt, so the next push is not reported as a conflict.
+
+Examples:
+  linear document push spec.md
+  linear document push spec.md --team ENG
+  linear document push spec.md --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("READ_ERROR", err.Error())
+			}
+
+			file, err := docfile.Parse(data)
+			if err != nil {
+				msg := fmt.Sprintf("%s: %s", path, err.Error())
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FILE", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			fm := file.FrontMatter
+			var (
+				document  *api.Document
+				operation string
+			)
+
+			if fm.ID == "" {
+				operation = "create"
+
+				var teamID string
+				if teamKey == "" {
+					teamKey = GetTeamID()
+				}
+				if teamKey != "" && fm.Project == "" {
+					team, err := client.GetTeamByKey(ctx, teamKey)
+					if err != nil {
+						if IsHumanOutput() {
+							output.ErrorHuman(err.Error())
+							return nil
+						}
+						return output.Error("API_ERROR", err.Error())
+					}
+					if team != nil {
+						teamID = team.ID
+					}
+				}
+
+				if fm.Project == "" && teamID == "" {
+					hint := "Set project in the front matter or pass --team"
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint("New documents need a project or team", hint)
+						return nil
+					}
+					return output.ErrorWithHint("MISSING_ASSOCIATION", "New documents need a project or team", hint)
+				}
+
+				document, err = client.CreateDocument(ctx, api.DocumentCreateInput{
+					Title:     documentFileTitle(file, path),
+					Content:   file.Content,
+					ProjectID: fm.Project,
+					TeamID:    teamID,
+					Icon:      fm.Icon,
+					Color:     fm.Color,
+				})
+			} else {
+				operation = "update"
+
+				var remote *api.Document
+				remote, err = client.GetDocument(ctx, fm.ID)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				if remote == nil {
+					msg := fmt.Sprintf("Document '%s' not found", fm.ID)
+					hint := "Remove the id from the front matter to create a new document"
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(msg, hint)
+						return nil
+					}
+					return output.ErrorWithHint("NOT_FOUND", msg, hint)
+				}
+
+				if remote.UpdatedAt != fm.UpdatedAt && !force {
+					pulled := fm.UpdatedAt
+					if pulled == "" {
+						pulled = "unknown"
+					}
+					msg := fmt.Sprintf("Document '%s' changed in Linear since it was pulled (pulled %s, now %s)",
+						remote.Title, pulled, remote.UpdatedAt)
+					hint := "Pull the latest version first, or pass --force to overwrite it"
+					example := fmt.Sprintf("linear document pull %s -o %s", fm.ID, path)
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(msg, hint, example)
+						return nil
+					}
+					return output.ErrorWithHint("CONFLICT", msg, hint, example)
+				}
+
+				document, err = client.UpdateDocument(ctx, fm.ID, api.DocumentUpdateInput{
+					Title:     fm.Title,
+					Content:   file.Content,
+					ProjectID: api.Value(fm.Project),
+					Icon:      api.Value(fm.Icon),
+					Color:     api.Value(fm.Color),
+				})
+			}
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			// Record the new revision so the next push doesn't conflict
+			fm.ID = document.ID
+			fm.Title = document.Title
+			fm.UpdatedAt = document.UpdatedAt
+			if document.Project != nil {
+				fm.Project = document.Project.ID
+			}
+			file.FrontMatter = fm
+
+			if err := os.WriteFile(path, docfile.Format(*file), 0644); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("WRITE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				if operation == "create" {
+					output.SuccessHuman(fmt.Sprintf("Document created: %s", document.Title))
+				} else {
+					output.SuccessHuman(fmt.Sprintf("Document updated: %s", document.Title))
+				}
+				output.HumanLn("  ID: %s", document.ID)
+				output.HumanLn("  URL: %s", document.URL)
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": operation,
+				"path":      path,
+				"document":  document,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&teamKey, "team", "", "Team key for new documents without a project (e.g., ENG)")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Overwrite remote changes made since the last pull")
+
+	return cmd
+}
+
+// DocumentSyncResult is the outcome of syncing one file
+type DocumentSyncResult struct {
+	Path       string `json:"path"`
+	DocumentID string `json:"documentId,omitempty"`
+	// Action is one of created, updated, pulled, merged, archived, deleted,
+	// removed, unchanged, conflict, error, or skipped. Path "." is used
+	// for the listing of new documents.
+	Action  string `json:"action"`
+	Message string `json:"message,omitempty"`
+	// Diff is a diff3-style merge for conflicts, or what would be
+	// pushed or pulled in a dry run
+	Diff string `json:"diff,omitempty"`
+}
+
+// DocumentSyncResponse is the response for document sync
+type DocumentSyncResponse struct {
+	Success   bool                 `json:"success"`
+	Directory string               `json:"directory"`
+	Project   string               `json:"project"`
+	DryRun    bool                 `json:"dryRun"`
+	Results   []DocumentSyncResult `json:"results"`
+	Summary   map[string]int       `json:"summary"`
+	Conflicts int                  `json:"conflicts"`
+}
+
+func newDocumentSyncCmd() *cobra.Command {
+	var (
+		prefer string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sync <directory>",
+		Short: "Sync a directory of Markdown files with project documents",
+		Long: `Two-way sync between the Markdown files in a directory and the documents
+of a project.
+
+Files are mapped to documents by a lock file (` + docfile.LockFileName + `) in the
+directory, which also records each document's title and content at the
+last sync. Commit it alongside the files.
+
+  - New files are created as documents (title from front matter, the first
+    "# " heading, or the file name). Files with an id in their front
+    matter, such as those written by 'linear document pull', are matched
+    to that document instead
+  - New documents in the project are pulled into files named after their
+    titles
+  - Local edits and renames are pushed, and edits and renames made in
+    Linear are pulled back
+  - Edits on both sides are merged line by line; overlapping edits, or
+    different renames, are reported as conflicts with a three-way diff and
+    neither side is changed
+  - Deleted files archive their document; documents deleted or trashed in
+    Linear delete their file
+
+Files with front matter keep it, with the id, title and updatedAt of the
+synced revision, so 'linear document push' works on them afterwards.
+The project is remembered in the lock file after the first sync.
+
+To resolve a conflict, edit the file and sync with --prefer local, or take
+Linear's version with --prefer remote. Either flag also resolves conflicts
+without intervention, e.g. in CI. The JSON result has success=false if any
+file conflicted or failed; use --dry-run to preview the changes as diffs.
+
+Examples:
+  linear document sync ./docs --project abc123
+  linear document sync ./docs --dry-run
+  linear document sync ./docs --prefer local`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := args[0]
+
+			if prefer != "" && prefer != "local" && prefer != "remote" {
+				msg := fmt.Sprintf("Invalid --prefer value '%s' (must be local or remote)", prefer)
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+				msg := fmt.Sprintf("'%s' is not a directory", dir)
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("READ_ERROR", msg)
+			}
+
+			lock, err := docfile.ReadLock(dir)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FILE", err.Error())
+			}
+
+			projectID := GetProjectID()
+			if projectID != "" && lock.Project != "" && projectID != lock.Project {
+				msg := fmt.Sprintf("'%s' is synced with project %s, not %s", dir, lock.Project, projectID)
+				hint := "Drop --project, or remove " + docfile.LockFileName + " to start over"
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(msg, hint)
+					return nil
+				}
+				return output.ErrorWithHint("PROJECT_MISMATCH", msg, hint)
+			}
+			if projectID == "" {
+				projectID = lock.Project
+			}
+			if projectID == "" {
+				hint := "Pass the project for the first sync; it's remembered in " + docfile.LockFileName
+				example := fmt.Sprintf("linear document sync %s --project <project-id>", dir)
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint("Project is required", hint, example)
+					return nil
+				}
+				return output.ErrorWithHint("MISSING_PROJECT", "Project is required", hint, example)
+			}
+			lock.Project = projectID
+
+			files, err := docfile.ListFiles(dir)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("READ_ERROR", err.Error())
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			s := &documentSync{
+				ctx:       ctx,
+				client:    client,
+				dir:       dir,
+				projectID: projectID,
+				lock:      lock,
+				prefer:    prefer,
+				dryRun:    dryRun,
+				adopted:   map[string]string{},
+			}
+			s.matchFiles(files)
+
+			// Every file on disk plus every file the lock file remembers
+			paths := append([]string{}, files...)
+			for path := range lock.Documents {
+				if !containsString(files, path) {
+					paths = append(paths, path)
+				}
+			}
+			sort.Strings(paths)
+
+			response := &DocumentSyncResponse{
+				Directory: dir,
+				Project:   projectID,
+				DryRun:    dryRun,
+				Results:   make([]DocumentSyncResult, 0, len(paths)),
+				Summary:   map[string]int{},
+			}
+			for _, path := range paths {
+				var result DocumentSyncResult
+				if err := ctx.Err(); err != nil {
+					result = DocumentSyncResult{Path: path, Action: "skipped", Message: interruptReason(err)}
+				} else {
+					result = s.syncFile(path)
+				}
+				response.Results = append(response.Results, result)
+			}
+
+			// Documents added in Linear are pulled into new files
+			if err := ctx.Err(); err != nil {
+				response.Results = append(response.Results, DocumentSyncResult{
+					Path: ".", Action: "skipped", Message: interruptReason(err) + " before new documents were listed",
+				})
+			} else if documents, err := client.GetProjectDocuments(ctx, projectID); err != nil {
+				response.Results = append(response.Results, DocumentSyncResult{
+					Path: ".", Action: "error", Message: "failed to list project documents: " + err.Error(),
+				})
+			} else {
+				response.Results = append(response.Results, s.pullNew(documents, paths)...)
+			}
+
+			for _, result := range response.Results {
+				response.Summary[result.Action]++
+			}
+			response.Conflicts = response.Summary["conflict"]
+			response.Success = response.Conflicts == 0 &&
+				response.Summary["error"] == 0 && response.Summary["skipped"] == 0
+
+			// Saved even when some files failed, so completed changes
+			// aren't repeated next time
+			if !dryRun {
+				if err := docfile.WriteLock(dir, lock); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("WRITE_ERROR", err.Error())
+				}
+			}
+
+			if IsHumanOutput() {
+				printDocumentSyncHuman(response)
+				return nil
+			}
+			return output.JSON(response)
+		},
+	}
+
+	cmd.Flags().StringVar(&prefer, "prefer", "", "Resolve conflicts by keeping one side (local, remote)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would change without changing anything")
+
+	return cmd
+}
+
+// documentSync holds the state of one document sync run
+type documentSync struct {
+	ctx       context.Context
+	client    *api.Client
+	dir       string
+	projectID string
+	lock      *docfile.Lock
+	prefer    string
+	dryRun    bool
+	// adopted maps untracked files to the document named by the id in
+	// their front matter, e.g. files written by 'document pull'
+	adopted map[string]string
+}
+
+// matchFiles maps untracked files to documents by their front matter id.
+// A file whose document is tracked under a path that no longer exists was
+// renamed, so the lock entry moves with it. Files that can't be read are
+// left for syncFile to report.
+func (s *documentSync) matchFiles(files []string) {
+	trackedAt := map[string]string{}
+	for path, entry := range s.lock.Documents {
+		trackedAt[entry.ID] = path
+	}
+
+	for _, path := range files {
+		if _, ok := s.lock.Documents[path]; ok {
+			continue
+		}
+		local, err := s.readFile(path)
+		if err != nil || local == nil || local.FrontMatter.ID == "" {
+			continue
+		}
+
+		id := local.FrontMatter.ID
+		old, tracked := trackedAt[id]
+		switch {
+		case !tracked:
+			s.adopted[path] = id
+			trackedAt[id] = path
+		case !containsString(files, old):
+			s.lock.Documents[path] = s.lock.Documents[old]
+			delete(s.lock.Documents, old)
+			trackedAt[id] = path
+		}
+	}
+}
+
+// trackedIDs returns the documents a file is synced with
+func (s *documentSync) trackedIDs() map[string]bool {
+	ids := map[string]bool{}
+	for _, entry := range s.lock.Documents {
+		ids[entry.ID] = true
+	}
+	for _, id := range s.adopted {
+		ids[id] = true
+	}
+	return ids
+}
+
+// getDocument fetches a document, or returns nil if it was deleted
+func (s *documentSync) getDocument(id string) (*api.Document, error) {
+	document, err := s.client.GetDocument(s.ctx, id)
+	if err != nil || document == nil || document.Deleted() {
+		return nil, err
+	}
+	return document, nil
+}
+
+// syncFile brings one file and its document in line, updating the lock
+func (s *documentSync) syncFile(path string) DocumentSyncResult {
+	entry, tracked := s.lock.Documents[path]
+	result := DocumentSyncResult{Path: path, DocumentID: entry.ID}
+
+	fail := func(err error) DocumentSyncResult {
+		result.Action = "error"
+		result.Message = err.Error()
+		return result
+	}
+
+	local, err := s.readFile(path)
+	if err != nil {
+		return fail(err)
+	}
+
+	create := func(message string) DocumentSyncResult {
+		result.Action = "created"
+		result.Message = message
+		if s.dryRun {
+			return result
+		}
+		document, err := s.create(path, local)
+		if err != nil {
+			return fail(err)
+		}
+		result.DocumentID = document.ID
+		return result
+	}
+
+	var remote *api.Document
+	if !tracked {
+		id, ok := s.adopted[path]
+		if !ok {
+			return create("")
+		}
+		if remote, err = s.getDocument(id); err != nil {
+			return fail(err)
+		}
+		if remote == nil {
+			return create("its front matter id is not a document in Linear")
+		}
+		if remote.Project == nil || remote.Project.ID != s.projectID {
+			return fail(fmt.Errorf("front matter id %s is a document in another project", id))
+		}
+		entry = adoptedEntry(local, remote)
+		result.DocumentID = id
+	} else if remote, err = s.getDocument(entry.ID); err != nil {
+		return fail(err)
+	}
+
+	base := entry.Base
+	localChanged := local != nil && normalizeContent(local.Content) != base
+	remoteChanged := remote != nil && remote.UpdatedAt != entry.UpdatedAt &&
+		normalizeContent(remote.Content) != base
+
+	switch {
+	case local == nil && remote == nil:
+		result.Action = "removed"
+		result.Message = "deleted locally and in Linear"
+		if !s.dryRun {
+			delete(s.lock.Documents, path)
+		}
+		return result
+
+	case local == nil:
+		if remoteChanged && s.prefer != "local" {
+			if s.prefer == "" {
+				result.Action = "conflict"
+				result.Message = "deleted locally but edited in Linear"
+				result.Diff = docfile.Diff("base", "remote", base, normalizeContent(remote.Content))
+				return result
+			}
+			return s.apply(path, nil, remote, remote.Title, normalizeContent(remote.Content), result)
+		}
+		result.Action = "archived"
+		if s.dryRun {
+			return result
+		}
+		if err := s.client.DeleteDocument(s.ctx, entry.ID); err != nil {
+			return fail(err)
+		}
+		delete(s.lock.Documents, path)
+		return result
+
+	case remote == nil:
+		if localChanged && s.prefer != "remote" {
+			if s.prefer == "" {
+				result.Action = "conflict"
+				result.Message = "edited locally but deleted in Linear"
+				result.Diff = docfile.Diff("base", "local", base, normalizeContent(local.Content))
+				return result
+			}
+			return create("recreated after it was deleted in Linear")
+		}
+		result.Action = "deleted"
+		result.Message = "deleted in Linear"
+		if s.dryRun {
+			return result
+		}
+		if err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(path))); err != nil {
+			return fail(err)
+		}
+		delete(s.lock.Documents, path)
+		return result
+	}
+
+	localContent := normalizeContent(local.Content)
+	remoteContent := normalizeContent(remote.Content)
+
+	content := localContent
+	merged, clean := "", true
+	switch {
+	case localChanged && remoteChanged:
+		merged, clean = docfile.Merge(base, localContent, remoteContent)
+		content = merged
+	case remoteChanged:
+		content = remoteContent
+	}
+
+	// A rename on one side wins; lock files written before titles were
+	// recorded treat the document's title as the synced one
+	localTitle := documentFileTitle(local, path)
+	syncedTitle := entry.Title
+	if syncedTitle == "" {
+		syncedTitle = remote.Title
+	}
+	title := remote.Title
+	if localTitle != syncedTitle {
+		title = localTitle
+	}
+	titleConflict := localTitle != syncedTitle && remote.Title != syncedTitle && localTitle != remote.Title
+
+	message := ""
+	if !clean || titleConflict {
+		switch s.prefer {
+		case "local":
+			if !clean {
+				content = localContent
+			}
+			message = "kept the local side of conflicting edits"
+		case "remote":
+			if !clean {
+				content = remoteContent
+			}
+			title = remote.Title
+			message = "kept the Linear side of conflicting edits"
+		default:
+			result.Action = "conflict"
+			switch {
+			case !clean && titleConflict:
+				result.Message = fmt.Sprintf("edited locally and in Linear, and renamed to %q locally and %q in Linear", localTitle, remote.Title)
+			case !clean:
+				result.Message = "edited locally and in Linear"
+			default:
+				result.Message = fmt.Sprintf("renamed to %q locally and %q in Linear", localTitle, remote.Title)
+			}
+			result.Diff = merged
+			return result
+		}
+	}
+
+	result = s.apply(path, local, remote, title, content, result)
+	if message != "" && result.Action != "error" {
+		result.Message = message
+	}
+	return result
+}
+
+// adoptedEntry builds the lock entry for a file matched to its document by
+// front matter id. If the file was pulled at the document's current
+// revision, or matches it, the document's content is the common base.
+// Otherwise the base is unknown, so differing content on both sides is a
+// conflict rather than a guess.
+func adoptedEntry(local *docfile.File, remote *api.Document) docfile.LockEntry {
+	entry := docfile.LockEntry{
+		ID:        remote.ID,
+		UpdatedAt: local.FrontMatter.UpdatedAt,
+		Title:     local.FrontMatter.Title,
+	}
+	remoteContent := normalizeContent(remote.Content)
+	if local.FrontMatter.UpdatedAt == remote.UpdatedAt || normalizeContent(local.Content) == remoteContent {
+		entry.Base = remoteContent
+	}
+	return entry
+}
+
+// apply makes both the document and the file hold title and content,
+// updating whichever differs, and records the new revision. In a dry run
+// it only reports the diff.
+func (s *documentSync) apply(path string, local *docfile.File, remote *api.Document, title, content string, result DocumentSyncResult) DocumentSyncResult {
+	remoteContent := normalizeContent(remote.Content)
+	localContent := ""
+	if local != nil {
+		localContent = normalizeContent(local.Content)
+	}
+
+	push := title != remote.Title || content != remoteContent
+	pull := local == nil || content != localContent || title != documentFileTitle(local, path)
+
+	switch {
+	case push && pull:
+		result.Action = "merged"
+		if s.dryRun {
+			result.Diff = docfile.Diff("local", "merged", localContent, content)
+		}
+	case push:
+		result.Action = "updated"
+		if s.dryRun {
+			result.Diff = docfile.Diff("remote", "local", remoteContent, content)
+		}
+	case pull:
+		result.Action = "pulled"
+		if s.dryRun {
+			result.Diff = docfile.Diff("local", "remote", localContent, content)
+		}
+	default:
+		result.Action = "unchanged"
+	}
+	if s.dryRun {
+		return result
+	}
+
+	updatedAt := remote.UpdatedAt
+	if push {
+		input := api.DocumentUpdateInput{}
+		if title != remote.Title {
+			input.Title = title
+		}
+		if content != remoteContent {
+			input.Content = content
+		}
+		document, err := s.client.UpdateDocument(s.ctx, remote.ID, input)
+		if err != nil {
+			result.Action = "error"
+			result.Message = err.Error()
+			return result
+		}
+		updatedAt = document.UpdatedAt
+	}
+
+	// The lock is only updated once the file is written, so a failed write
+	// is retried as a merge next time rather than pushing the stale file
+	entry := docfile.LockEntry{ID: remote.ID, UpdatedAt: updatedAt, Title: title, Base: content}
+	if err := s.writeFile(path, local, content, entry); err != nil {
+		result.Action = "error"
+		result.Message = err.Error()
+		return result
+	}
+	s.lock.Documents[path] = entry
+	return result
+}
+
+// create creates a document in the project from the file and records it
+func (s *documentSync) create(path string, local *docfile.File) (*api.Document, error) {
+	content := normalizeContent(local.Content)
+	title := documentFileTitle(local, path)
+	document, err := s.client.CreateDocument(s.ctx, api.DocumentCreateInput{
+		Title:     title,
+		Content:   content,
+		ProjectID: s.projectID,
+		Icon:      local.FrontMatter.Icon,
+		Color:     local.FrontMatter.Color,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := docfile.LockEntry{ID: document.ID, UpdatedAt: document.UpdatedAt, Title: title, Base: content}
+	s.lock.Documents[path] = entry
+	// Front matter copied from another document now points at this one
+	return document, s.writeFile(path, local, content, entry)
+}
+
+// pullNew writes the project's documents that no file is synced with to
+// new files named after their titles. taken holds the paths in use.
+func (s *documentSync) pullNew(documents []api.Document, taken []string) []DocumentSyncResult {
+	tracked := s.trackedIDs()
+	used := map[string]bool{}
+	for _, path := range taken {
+		used[strings.ToLower(path)] = true
+	}
+
+	var results []DocumentSyncResult
+	for i := range documents {
+		remote := &documents[i]
+		if tracked[remote.ID] || remote.Deleted() {
+			continue
+		}
+
+		path := newDocumentFilePath(remote.Title, used)
+		result := DocumentSyncResult{Path: path, DocumentID: remote.ID}
+		if err := s.ctx.Err(); err != nil {
+			result.Action = "skipped"
+			result.Message = interruptReason(err)
+		} else {
+			result = s.apply(path, nil, remote, remote.Title, normalizeContent(remote.Content), result)
+			if result.Action != "error" {
+				result.Message = "new in Linear"
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// newDocumentFilePath returns an unused file name for a document, from its
+// title, and marks it used
+func newDocumentFilePath(title string, used map[string]bool) string {
+	name := slugify(title)
+	if name == "" {
+		name = "document"
+	}
+	path := name + ".md"
+	for i := 2; used[strings.ToLower(path)]; i++ {
+		path = fmt.Sprintf("%s-%d.md", name, i)
+	}
+	used[strings.ToLower(path)] = true
+	return path
+}
+
+// readFile parses a file in the synced directory, or returns nil if it
+// doesn't exist
+func (s *documentSync) readFile(path string) (*docfile.File, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, filepath.FromSlash(path)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return docfile.Parse(data)
+}
+
+// writeFile sets a file's content, creating the file if needed. Front
+// matter gets the id, title and updatedAt of entry, so a later 'document
+// push' sees the synced revision; it is added when the title can't be
+// derived from the content or file name, and when the content itself
+// starts with "---" and would otherwise be read back as front matter.
+// Unknown front matter keys of the local file are kept. Files already in
+// that state are not rewritten.
+func (s *documentSync) writeFile(path string, local *docfile.File, content string, entry docfile.LockEntry) error {
+	file := docfile.File{Content: content}
+	if local != nil {
+		file.FrontMatter, file.Extra = local.FrontMatter, local.Extra
+	}
+	if file.FrontMatter != (docfile.FrontMatter{}) || len(file.Extra) > 0 ||
+		strings.HasPrefix(content, "---\n") ||
+		documentFileTitle(&docfile.File{Content: content}, path) != entry.Title {
+		file.FrontMatter.ID, file.FrontMatter.Title, file.FrontMatter.UpdatedAt = entry.ID, entry.Title, entry.UpdatedAt
+	}
+
+	if local != nil && local.FrontMatter == file.FrontMatter && normalizeContent(local.Content) == content {
+		return nil
+	}
+
+	data := []byte(content)
+	if file.FrontMatter != (docfile.FrontMatter{}) {
+		data = docfile.Format(file)
+	}
+
+	fullPath := filepath.Join(s.dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(fullPath, data, 0644)
+}
+
 // Human output formatters
 
 func printDocumentsHuman(documents *api.DocumentsResponse) {
@@ -642,3 +1671,94 @@ func printDocumentSearchHuman(results *api.DocumentSearchResponse) {
 	output.TableWithColors(headers, rows)
 	output.HumanLn("\n%d of %d documents", results.Count, results.TotalCount)
 }
+
+func printDocumentSyncHuman(response *DocumentSyncResponse) {
+	if response.DryRun {
+		output.HumanLn("%s", output.Muted("Dry run: nothing was changed"))
+	}
+
+	unchanged := 0
+	for _, r := range response.Results {
+		var action string
+		switch r.Action {
+		case "unchanged":
+			unchanged++
+			continue
+		case "conflict", "error":
+			action = output.Red("%-9s", r.Action)
+		case "skipped", "archived", "deleted", "removed":
+			action = output.Yellow("%-9s", r.Action)
+		default:
+			action = output.Green("%-9s", r.Action)
+		}
+
+		line := fmt.Sprintf("%s %s", action, r.Path)
+		if r.Message != "" {
+			line += output.Muted(" (%s)", r.Message)
+		}
+		output.HumanLn("%s", line)
+
+		if r.Action == "conflict" && r.Diff != "" {
+			output.HumanLn("%s", strings.TrimRight(r.Diff, "\n"))
+		}
+	}
+
+	actions := make([]string, 0, len(response.Summary))
+	for action := range response.Summary {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	counts := make([]string, len(actions))
+	for i, action := range actions {
+		counts[i] = fmt.Sprintf("%d %s", response.Summary[action], action)
+	}
+
+	if unchanged == len(response.Results) {
+		output.HumanLn("All %d files up to date", len(response.Results))
+		return
+	}
+	output.HumanLn("\n%s", strings.Join(counts, ", "))
+	if response.Conflicts > 0 {
+		output.HumanLn("Resolve a conflict by editing the file and re-running with --prefer local, or take Linear's version with --prefer remote")
+	}
+}
+
+// documentFrontMatter returns the front matter recorded for a document
+func documentFrontMatter(d *api.Document) docfile.FrontMatter {
+	fm := docfile.FrontMatter{
+		ID:        d.ID,
+		Title:     d.Title,
+		Icon:      d.Icon,
+		Color:     d.Color,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.Project != nil {
+		fm.Project = d.Project.ID
+	}
+	return fm
+}
+
+// documentFileTitle picks a title for a new document: the front matter
+// title, then the first "# " heading, then the file name
+func documentFileTitle(file *docfile.File, path string) string {
+	if file.FrontMatter.Title != "" {
+		return file.FrontMatter.Title
+	}
+	for _, line := range strings.Split(file.Content, "\n") {
+		if strings.HasPrefix(line, "# ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "# "))
+		}
+	}
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// normalizeContent ends non-empty content with exactly one newline so
+// trailing whitespace differences don't count as edits
+func normalizeContent(content string) string {
+	content = strings.TrimRight(content, "\n")
+	if content == "" {
+		return ""
+	}
+	return content + "\n"
+}
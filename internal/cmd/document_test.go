@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/docfile"
+)
+
+func newTestDocumentSync(t *testing.T) *documentSync {
+	t.Helper()
+	return &documentSync{
+		ctx:  context.Background(),
+		dir:  t.TempDir(),
+		lock: &docfile.Lock{Documents: map[string]docfile.LockEntry{}},
+	}
+}
+
+// resync syncs path against an unchanged remote, the way syncFile does once
+// the file has been read
+func resync(t *testing.T, s *documentSync, path string, remote *api.Document) (*docfile.File, DocumentSyncResult) {
+	t.Helper()
+	local, err := s.readFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if local == nil {
+		t.Fatalf("%s was not written", path)
+	}
+	result := s.apply(path, local, remote, documentFileTitle(local, path), normalizeContent(local.Content), DocumentSyncResult{Path: path})
+	return local, result
+}
+
+func TestDocumentSyncContentStartingWithDelimiter(t *testing.T) {
+	s := newTestDocumentSync(t)
+	remote := &api.Document{
+		ID:        "doc-1",
+		Title:     "Spec",
+		Content:   "---\nstatus: draft\n---\n# Spec\n",
+		UpdatedAt: "2026-01-01T00:00:00.000Z",
+	}
+
+	result := s.apply("spec.md", nil, remote, remote.Title, remote.Content, DocumentSyncResult{Path: "spec.md"})
+	if result.Action != "pulled" {
+		t.Fatalf("first sync = %+v, want pulled", result)
+	}
+
+	// The body's own "---" block must not be read back as front matter and
+	// pushed as an edit
+	local, result := resync(t, s, "spec.md", remote)
+	if local.Content != remote.Content {
+		t.Errorf("content = %q, want %q", local.Content, remote.Content)
+	}
+	if result.Action != "unchanged" {
+		t.Errorf("second sync = %+v, want unchanged", result)
+	}
+}
+
+func TestDocumentSyncKeepsUnknownFrontMatter(t *testing.T) {
+	s := newTestDocumentSync(t)
+	data := "---\nid: doc-1\ntitle: Spec\nlayout: post\ntags:\n  - a\n---\nold\n"
+	if err := os.WriteFile(filepath.Join(s.dir, "spec.md"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s.lock.Documents["spec.md"] = docfile.LockEntry{ID: "doc-1", Title: "Spec", Base: "old\n"}
+
+	remote := &api.Document{ID: "doc-1", Title: "Spec", Content: "new\n", UpdatedAt: "2026-01-02T00:00:00.000Z"}
+	local, err := s.readFile("spec.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := s.apply("spec.md", local, remote, "Spec", remote.Content, DocumentSyncResult{Path: "spec.md"}); result.Action != "pulled" {
+		t.Fatalf("sync = %+v, want pulled", result)
+	}
+
+	local, result := resync(t, s, "spec.md", remote)
+	if want := []string{"layout: post", "tags:", "  - a"}; !reflect.DeepEqual(local.Extra, want) {
+		t.Errorf("Extra = %q, want %q", local.Extra, want)
+	}
+	if local.Content != "new\n" || local.FrontMatter.UpdatedAt != remote.UpdatedAt {
+		t.Errorf("file = %+v", *local)
+	}
+	if result.Action != "unchanged" {
+		t.Errorf("second sync = %+v, want unchanged", result)
+	}
+}
@@ -0,0 +1,437 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// notificationTypeGroups maps friendly --type values to substrings of
+// Linear notification types (e.g. issueMention, issueAssignedToYou)
+var notificationTypeGroups = map[string]string{
+	"mention":    "mention",
+	"assignment": "assigned",
+	"status":     "statuschanged",
+	"comment":    "comment",
+}
+
+// NotificationBatchResult is the per-item result of a batch inbox operation
+type NotificationBatchResult struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NewInboxCmd creates the inbox command group
+func NewInboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "inbox",
+		Aliases: []string{"notifications"},
+		Short:   "Manage your notification inbox",
+		Long: `List and triage your Linear notifications (mentions, assignments,
+status changes, comments).
+
+Examples:
+  linear inbox list --unread
+  linear inbox list --type mention --type assignment
+  linear inbox read <notification-id>
+  linear inbox snooze <notification-id> --until 4h`,
+	}
+
+	cmd.AddCommand(newInboxListCmd())
+	cmd.AddCommand(newInboxReadCmd())
+	cmd.AddCommand(newInboxUnreadCmd())
+	cmd.AddCommand(newInboxArchiveCmd())
+	cmd.AddCommand(newInboxSnoozeCmd())
+
+	return cmd
+}
+
+func newInboxListCmd() *cobra.Command {
+	var (
+		types           []string
+		unreadOnly      bool
+		includeArchived bool
+		markRead        bool
+		limit           int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List notifications",
+		Long: `List notifications in your inbox, newest first.
+
+Type filters: mention, assignment, status, comment
+Any other value is matched exactly against the Linear notification type
+(e.g., issueNewComment, issuePriorityUrgent).
+
+Use --mark-read to acknowledge every listed notification in one step.
+
+Examples:
+  linear inbox list
+  linear inbox list --unread
+  linear inbox list --type mention --type assignment
+  linear inbox list --unread --mark-read`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			var keep func(api.Notification) bool
+			if unreadOnly || len(types) > 0 {
+				keep = func(n api.Notification) bool {
+					return matchesNotificationFilters(n, types, unreadOnly)
+				}
+			}
+
+			response, err := client.GetNotifications(ctx, limit, includeArchived, keep)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			var marked []NotificationBatchResult
+			if markRead {
+				ids := make([]string, 0, len(response.Notifications))
+				for _, n := range response.Notifications {
+					if n.ReadAt == "" {
+						ids = append(ids, n.ID)
+					}
+				}
+				marked = applyNotificationBatch(ctx, ids, client.MarkNotificationRead)
+			}
+
+			if IsHumanOutput() {
+				printNotificationsHuman(response)
+				if markRead {
+					output.HumanLn("Marked %d notifications as read", countBatchSuccess(marked))
+				}
+			} else if markRead {
+				output.JSON(map[string]interface{}{
+					"notifications": response.Notifications,
+					"count":         response.Count,
+					"markedRead":    marked,
+				})
+			} else {
+				output.JSON(response)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&types, "type", nil, "Filter by type (mention, assignment, status, comment, or a raw Linear type)")
+	cmd.Flags().BoolVarP(&unreadOnly, "unread", "u", false, "Show only unread notifications")
+	cmd.Flags().BoolVar(&includeArchived, "include-archived", false, "Include archived notifications")
+	cmd.Flags().BoolVar(&markRead, "mark-read", false, "Mark the listed notifications as read")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum notifications to return (after filters)")
+
+	return cmd
+}
+
+func newInboxReadCmd() *cobra.Command {
+	return newInboxBatchCmd(
+		"read <notification-id>...",
+		"Mark notifications as read",
+		"read",
+		"Marked %d notifications as read",
+		func(client *api.Client) func(context.Context, string) error {
+			return client.MarkNotificationRead
+		},
+	)
+}
+
+func newInboxUnreadCmd() *cobra.Command {
+	return newInboxBatchCmd(
+		"unread <notification-id>...",
+		"Mark notifications as unread",
+		"unread",
+		"Marked %d notifications as unread",
+		func(client *api.Client) func(context.Context, string) error {
+			return client.MarkNotificationUnread
+		},
+	)
+}
+
+func newInboxArchiveCmd() *cobra.Command {
+	return newInboxBatchCmd(
+		"archive <notification-id>...",
+		"Archive notifications",
+		"archive",
+		"Archived %d notifications",
+		func(client *api.Client) func(context.Context, string) error {
+			return client.ArchiveNotification
+		},
+	)
+}
+
+// newInboxBatchCmd builds a command that applies one operation to each
+// notification ID given as an argument
+func newInboxBatchCmd(use, short, operation, doneFormat string, op func(*api.Client) func(context.Context, string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: fmt.Sprintf(`%s.
+
+Accepts one or more notification IDs from 'linear inbox list'.
+
+Examples:
+  linear inbox %s abc123
+  linear inbox %s abc123 def456`, short, operation, operation),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			results := applyNotificationBatch(ctx, args, op(client))
+			return printNotificationBatch(operation, doneFormat, results)
+		},
+	}
+}
+
+func newInboxSnoozeCmd() *cobra.Command {
+	var until string
+
+	cmd := &cobra.Command{
+		Use:   "snooze <notification-id>...",
+		Short: "Snooze notifications",
+		Long: `Hide notifications until a later time.
+
+The --until flag accepts a duration (30m, 4h, 2d, 1w), a date (2025-01-31)
+or an RFC 3339 timestamp.
+
+Examples:
+  linear inbox snooze abc123 --until 4h
+  linear inbox snooze abc123 def456 --until 2025-02-01`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			untilTime, err := parseUntil(until)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						err.Error(),
+						"Use a duration like 4h or 2d, a date, or an RFC 3339 timestamp",
+						"linear inbox snooze abc123 --until 4h",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"INVALID_UNTIL",
+					err.Error(),
+					"Use a duration like 4h or 2d, a date, or an RFC 3339 timestamp",
+					"linear inbox snooze abc123 --until 4h",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			results := applyNotificationBatch(ctx, args, func(ctx context.Context, id string) error {
+				return client.SnoozeNotification(ctx, id, untilTime)
+			})
+			return printNotificationBatch("snooze", "Snoozed %d notifications until "+display.FormatDateTime(untilTime), results)
+		},
+	}
+
+	cmd.Flags().StringVar(&until, "until", "1d", "Snooze until (duration, date, or timestamp)")
+
+	return cmd
+}
+
+// matchesNotificationFilters applies the type and read-state filters
+func matchesNotificationFilters(n api.Notification, types []string, unreadOnly bool) bool {
+	if unreadOnly && n.ReadAt != "" {
+		return false
+	}
+	return len(types) == 0 || matchesNotificationType(n.Type, types)
+}
+
+func matchesNotificationType(notificationType string, types []string) bool {
+	lower := strings.ToLower(notificationType)
+	for _, t := range types {
+		if group, ok := notificationTypeGroups[strings.ToLower(t)]; ok {
+			if strings.Contains(lower, group) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(notificationType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyNotificationBatch runs op for each ID and collects per-item results.
+// Once ctx is cancelled the remaining IDs are marked skipped.
+func applyNotificationBatch(ctx context.Context, ids []string, op func(context.Context, string) error) []NotificationBatchResult {
+	results := make([]NotificationBatchResult, len(ids))
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			results[i] = NotificationBatchResult{ID: id, Skipped: true, Error: interruptReason(err)}
+			continue
+		}
+		results[i] = NotificationBatchResult{ID: id, Success: true}
+		if err := op(ctx, id); err != nil {
+			results[i].Success = false
+			results[i].Error = err.Error()
+		}
+	}
+	return results
+}
+
+func countBatchSkipped(results []NotificationBatchResult) int {
+	count := 0
+	for _, r := range results {
+		if r.Skipped {
+			count++
+		}
+	}
+	return count
+}
+
+func countBatchSuccess(results []NotificationBatchResult) int {
+	count := 0
+	for _, r := range results {
+		if r.Success {
+			count++
+		}
+	}
+	return count
+}
+
+func printNotificationBatch(operation, doneFormat string, results []NotificationBatchResult) error {
+	succeeded := countBatchSuccess(results)
+	skipped := countBatchSkipped(results)
+
+	if IsHumanOutput() {
+		for _, r := range results {
+			if !r.Success && !r.Skipped {
+				output.ErrorHuman(fmt.Sprintf("%s: %s", r.ID, r.Error))
+			}
+		}
+		if succeeded > 0 {
+			output.SuccessHuman(fmt.Sprintf(doneFormat, succeeded))
+		}
+		if skipped > 0 {
+			output.ErrorHuman(fmt.Sprintf("Interrupted: %d of %d notifications were not processed", skipped, len(results)))
+		}
+		return nil
+	}
+
+	resp := map[string]interface{}{
+		"success":   succeeded == len(results),
+		"operation": operation,
+		"results":   results,
+		"count":     succeeded,
+	}
+	if skipped > 0 {
+		resp["interrupted"] = true
+		resp["skipped"] = skipped
+	}
+	return output.JSON(resp)
+}
+
+// parseUntil parses a future point in time: a duration (30m, 4h, 2d, 1w),
+// a date, or an RFC 3339 timestamp
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	// parseSince resolves durations into the past; mirror it forward
+	if since, err := parseSince(value); err == nil {
+		return time.Now().Add(time.Since(since)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --until value: %s", value)
+}
+
+// Human output formatters
+
+func printNotificationsHuman(response *api.NotificationsResponse) {
+	if len(response.Notifications) == 0 {
+		output.HumanLn("Inbox zero - no notifications")
+		return
+	}
+
+	headers := []string{"", "TYPE", "SUBJECT", "FROM", "WHEN", "ID"}
+	rows := make([][]string, len(response.Notifications))
+
+	for i, n := range response.Notifications {
+		unread := ""
+		if n.ReadAt == "" {
+			unread = output.Cyan("●")
+		}
+
+		subject := "-"
+		switch {
+		case n.Issue != nil:
+			subject = n.Issue.Identifier + " " + n.Issue.Title
+		case n.Project != nil:
+			subject = n.Project.Name
+		case n.Document != nil:
+			subject = n.Document.Title
+		case n.DocumentID != "":
+			subject = "Document " + n.DocumentID
+		}
+
+		actor := "-"
+		if n.Actor != nil {
+			actor = n.Actor.DisplayName
+		}
+
+		when := n.CreatedAt
+		if t, err := time.Parse(time.RFC3339, n.CreatedAt); err == nil {
+			when = display.TimeAgo(t)
+		}
+
+		rows[i] = []string{
+			unread,
+			n.Type,
+			display.Truncate(subject, 50),
+			actor,
+			output.Muted("%s", when),
+			output.Muted("%s", n.ID),
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d notifications", response.Count)
+}
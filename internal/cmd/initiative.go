@@ -1,8 +1,8 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
@@ -33,6 +33,8 @@ Examples:
 	cmd.AddCommand(newInitiativeRestoreCmd())
 	cmd.AddCommand(newInitiativeProjectAddCmd())
 	cmd.AddCommand(newInitiativeProjectRemoveCmd())
+	cmd.AddCommand(newInitiativeUpdateStatusCmd())
+	cmd.AddCommand(newInitiativeChildCmd())
 
 	return cmd
 }
@@ -56,7 +58,7 @@ Examples:
   linear initiative list --status Active
   linear initiative list --limit 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -97,14 +99,18 @@ func newInitiativeViewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "view <initiative-id>",
 		Short: "View initiative details",
-		Long: `View detailed information about an initiative.
+		Long: `View detailed information about an initiative, including its parent and
+sub-initiatives, and a rollup of its projects' progress and health.
+
+Progress is the average of the linked projects' progress (canceled projects
+excluded). Health is the worst health among active projects.
 
 Examples:
   linear initiative view abc123`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -132,6 +138,8 @@ Examples:
 				return output.Error("NOT_FOUND", fmt.Sprintf("Initiative '%s' not found", initiativeID))
 			}
 
+			initiative.Rollup = rollupInitiativeProjects(initiative.Projects)
+
 			if IsHumanOutput() {
 				printInitiativeDetailHuman(initiative)
 			} else {
@@ -175,7 +183,7 @@ Examples:
 				return output.Error("MISSING_NAME", "Initiative name is required")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -238,23 +246,35 @@ func newInitiativeUpdateCmd() *cobra.Command {
 		status      string
 		ownerID     string
 		targetDate  string
+		unset       []string
 	)
 
+	clearable := []string{"owner", "target-date"}
+
 	cmd := &cobra.Command{
 		Use:   "update <initiative-id>",
 		Short: "Update an initiative",
 		Long: `Update an existing initiative.
 
+Use --unset to clear a field: owner, target-date.
+
 Examples:
   linear initiative update abc123 --name "New Name"
   linear initiative update abc123 --status Completed
-  linear initiative update abc123 --target-date 2025-06-30`,
+  linear initiative update abc123 --target-date 2025-06-30
+  linear initiative update abc123 --unset owner`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
 
+			cleared, err := resolveUnset(cmd, unset, clearable)
+			if err != nil {
+				return reportUnsetError(err, "linear initiative update abc123 --unset target-date")
+			}
+
 			// Check if at least one field is being updated
-			if !cmd.Flags().Changed("name") &&
+			if len(cleared) == 0 &&
+				!cmd.Flags().Changed("name") &&
 				!cmd.Flags().Changed("description") &&
 				!cmd.Flags().Changed("content") &&
 				!cmd.Flags().Changed("status") &&
@@ -267,7 +287,7 @@ Examples:
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -292,12 +312,8 @@ Examples:
 			if cmd.Flags().Changed("status") {
 				input.Status = status
 			}
-			if cmd.Flags().Changed("owner") {
-				input.OwnerID = ownerID
-			}
-			if cmd.Flags().Changed("target-date") {
-				input.TargetDate = targetDate
-			}
+			input.OwnerID = nullableField(cleared, "owner", ownerID)
+			input.TargetDate = nullableField(cleared, "target-date", targetDate)
 
 			initiative, err := client.UpdateInitiative(ctx, initiativeID, input)
 			if err != nil {
@@ -328,6 +344,7 @@ Examples:
 	cmd.Flags().StringVarP(&status, "status", "s", "", "Initiative status (Planned, Active, Completed)")
 	cmd.Flags().StringVarP(&ownerID, "owner", "o", "", "Owner user ID")
 	cmd.Flags().StringVarP(&targetDate, "target-date", "t", "", "Target date (YYYY-MM-DD)")
+	addUnsetFlag(cmd, &unset, clearable)
 
 	return cmd
 }
@@ -343,7 +360,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -391,7 +408,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -440,7 +457,7 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
 			projectID := args[1]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -490,7 +507,7 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			initiativeID := args[0]
 			projectID := args[1]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -605,11 +622,31 @@ func printInitiativeDetailHuman(init *api.Initiative) {
 	output.HumanLn("")
 	output.HumanLn("ID: %s", output.Muted("%s", init.ID))
 
+	if init.Parent != nil {
+		output.HumanLn("")
+		output.HumanLn("Parent: %s (%s)", init.Parent.Name, output.Muted("%s", init.Parent.ID))
+	}
+
+	if len(init.Children) > 0 {
+		output.HumanLn("")
+		output.HumanLn("Sub-initiatives:")
+		for _, c := range init.Children {
+			output.HumanLn("  - %s [%s] (%s)", c.Name, c.Status, output.Muted("%s", c.ID))
+		}
+	}
+
 	if len(init.Projects) > 0 {
 		output.HumanLn("")
+		if r := init.Rollup; r != nil {
+			output.HumanLn("Progress: %.0f%% across %d projects", r.Progress*100, r.ProjectCount)
+			if r.Health != "" {
+				output.HumanLn("Health: %s %s", formatProjectHealth(r.Health), formatHealthCounts(r.HealthCounts))
+			}
+			output.HumanLn("")
+		}
 		output.HumanLn("Projects:")
 		for _, p := range init.Projects {
-			output.HumanLn("  - %s (%s)", p.Name, output.Muted("%s", p.ID))
+			output.HumanLn("  - %s %s %.0f%% (%s)", p.Name, formatProjectHealth(p.Health), p.Progress*100, output.Muted("%s", p.ID))
 		}
 	}
 
@@ -625,3 +662,379 @@ func printInitiativeDetailHuman(init *api.Initiative) {
 		output.HumanLn("%s", init.Content)
 	}
 }
+
+func newInitiativeUpdateStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-status",
+		Aliases: []string{"updates"},
+		Short:   "Manage initiative status updates",
+		Long: `Create and list initiative status updates.
+
+Examples:
+  linear initiative update-status list <initiative-id>
+  linear initiative update-status create <initiative-id> --body "Progress update" --health onTrack`,
+	}
+
+	cmd.AddCommand(newInitiativeUpdateStatusListCmd())
+	cmd.AddCommand(newInitiativeUpdateStatusCreateCmd())
+
+	return cmd
+}
+
+func newInitiativeUpdateStatusListCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list <initiative-id>",
+		Short: "List status updates for an initiative",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initiativeID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			updates, err := client.GetInitiativeUpdates(ctx, initiativeID, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				printProjectUpdatesHuman(updates)
+			} else {
+				output.JSON(updates)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 10, "Maximum updates to return")
+
+	return cmd
+}
+
+func newInitiativeUpdateStatusCreateCmd() *cobra.Command {
+	var (
+		body   string
+		health string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <initiative-id>",
+		Short: "Create a status update",
+		Long: `Create a new status update for an initiative.
+
+Health values: onTrack, atRisk, offTrack
+
+Examples:
+  linear initiative update-status create abc123 --body "Launch prep on schedule"
+  linear initiative update-status create abc123 --body "Two projects slipping" --health atRisk`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			initiativeID := args[0]
+
+			if body == "" {
+				if IsHumanOutput() {
+					output.ErrorHuman("Update body is required. Use --body flag.")
+					return nil
+				}
+				return output.Error("MISSING_BODY", "Update body is required")
+			}
+
+			if health != "" && !containsString([]string{"onTrack", "atRisk", "offTrack"}, health) {
+				msg := fmt.Sprintf("invalid health %q (valid: onTrack, atRisk, offTrack)", health)
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			var healthPtr *string
+			if health != "" {
+				healthPtr = &health
+			}
+
+			update, err := client.CreateInitiativeUpdate(ctx, initiativeID, body, healthPtr)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Status update created")
+				output.HumanLn("  ID: %s", update.ID)
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "create",
+					"update":    update,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&body, "body", "b", "", "Update body (required)")
+	cmd.Flags().StringVar(&health, "health", "", "Initiative health (onTrack, atRisk, offTrack)")
+
+	return cmd
+}
+
+func newInitiativeChildCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "child",
+		Short: "Manage sub-initiatives",
+		Long: `List, add, or remove the initiatives nested under an initiative.
+
+Examples:
+  linear initiative child list <parent-id>
+  linear initiative child add <parent-id> <child-id>
+  linear initiative child remove <parent-id> <child-id>`,
+	}
+
+	cmd.AddCommand(newInitiativeChildListCmd())
+	cmd.AddCommand(newInitiativeChildAddCmd())
+	cmd.AddCommand(newInitiativeChildRemoveCmd())
+
+	return cmd
+}
+
+func newInitiativeChildListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <parent-id>",
+		Short: "List sub-initiatives",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parentID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			children, err := client.GetSubInitiatives(ctx, parentID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				if len(children) == 0 {
+					output.HumanLn("No sub-initiatives")
+					return nil
+				}
+				headers := []string{"NAME", "STATUS", "TARGET", "ID"}
+				rows := make([][]string, len(children))
+				for i, c := range children {
+					targetDate := "-"
+					if c.TargetDate != "" {
+						targetDate = c.TargetDate
+					}
+					rows[i] = []string{
+						display.Truncate(c.Name, 35),
+						c.Status,
+						targetDate,
+						output.Muted("%s", c.ID),
+					}
+				}
+				output.TableWithColors(headers, rows)
+				output.HumanLn("\n%d sub-initiatives", len(children))
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"initiativeId": parentID,
+				"children":     children,
+				"count":        len(children),
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newInitiativeChildAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <parent-id> <child-id>",
+		Short: "Nest an initiative under another",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parentID := args[0]
+			childID := args[1]
+
+			if parentID == childID {
+				if IsHumanOutput() {
+					output.ErrorHuman("An initiative cannot be its own sub-initiative")
+					return nil
+				}
+				return output.Error("INVALID_ARGS", "An initiative cannot be its own sub-initiative")
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if err := client.AddSubInitiative(ctx, parentID, childID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Sub-initiative added")
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":      true,
+					"operation":    "child-add",
+					"initiativeId": parentID,
+					"childId":      childID,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newInitiativeChildRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <parent-id> <child-id>",
+		Short: "Remove a sub-initiative",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			parentID := args[0]
+			childID := args[1]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if err := client.RemoveSubInitiative(ctx, parentID, childID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Sub-initiative removed")
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":      true,
+					"operation":    "child-remove",
+					"initiativeId": parentID,
+					"childId":      childID,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// healthRank orders project health from best to worst
+var healthRank = map[string]int{"onTrack": 1, "atRisk": 2, "offTrack": 3}
+
+// rollupInitiativeProjects averages project progress (excluding canceled
+// projects) and takes the worst health among active projects
+func rollupInitiativeProjects(projects []api.InitiativeProject) *api.InitiativeRollup {
+	rollup := &api.InitiativeRollup{HealthCounts: map[string]int{}}
+
+	total := 0.0
+	for _, p := range projects {
+		if p.State == "canceled" {
+			continue
+		}
+		rollup.ProjectCount++
+		total += p.Progress
+
+		if p.State == "completed" {
+			continue
+		}
+		health := p.Health
+		if health == "" {
+			health = "none"
+		}
+		rollup.HealthCounts[health]++
+		if healthRank[p.Health] > healthRank[rollup.Health] {
+			rollup.Health = p.Health
+		}
+	}
+
+	if rollup.ProjectCount > 0 {
+		rollup.Progress = total / float64(rollup.ProjectCount)
+	}
+
+	return rollup
+}
+
+func formatHealthCounts(counts map[string]int) string {
+	parts := []string{}
+	for _, h := range []struct{ key, label string }{
+		{"onTrack", "on track"},
+		{"atRisk", "at risk"},
+		{"offTrack", "off track"},
+		{"none", "no update"},
+	} {
+		if counts[h.key] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[h.key], h.label))
+		}
+	}
+	return output.Muted("(%s)", strings.Join(parts, ", "))
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -36,13 +35,14 @@ Examples:
 	// Add subcommands
 	cmd.AddCommand(newIssueListCmd())
 	cmd.AddCommand(newIssueViewCmd())
-	cmd.AddCommand(newIssueCreateCmd())
+	cmd.AddCommand(honorAppActor(newIssueCreateCmd()))
 	cmd.AddCommand(newIssueUpdateCmd())
 	cmd.AddCommand(newIssueDeleteCmd())
 	cmd.AddCommand(newIssueSearchCmd())
 	cmd.AddCommand(newIssueRelateCmd())
 	cmd.AddCommand(newIssueUnrelateCmd())
 	cmd.AddCommand(newIssueRelationsCmd())
+	cmd.AddCommand(newIssueHistoryCmd())
 	cmd.AddCommand(newIssueCommentCmd())
 	cmd.AddCommand(newIssueAttachmentCmd())
 
@@ -105,7 +105,7 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -223,7 +223,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -346,7 +346,7 @@ Examples:
 				)
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -436,6 +436,10 @@ Examples:
 				input.LabelIDs = labels
 			}
 
+			if ok, err := applyAppActor(client); !ok {
+				return err
+			}
+
 			result, err := client.CreateIssue(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
@@ -497,9 +501,14 @@ func newIssueUpdateCmd() *cobra.Command {
 		parentID    string
 		dueDate     string
 		cycleID     string
-		milestoneID string
+		milestoneID  string
+		unset        []string
+		addLabels    []string
+		removeLabels []string
 	)
 
+	clearable := []string{"assignee", "due-date", "project", "parent", "cycle", "milestone"}
+
 	cmd := &cobra.Command{
 		Use:   "update <issue-id>",
 		Short: "Update an issue",
@@ -507,18 +516,48 @@ func newIssueUpdateCmd() *cobra.Command {
 
 At least one field must be provided to update.
 
+Use --unset to clear a field: assignee, due-date, project, parent, cycle,
+milestone.
+
+--label replaces all labels. --add-label and --remove-label edit the
+current set instead; they take label names, Group/Name, or IDs. Adding a
+label from a group that already has one on the issue is reported as a
+conflict (LABEL_CONFLICT) rather than applied. The CLI has no bulk
+update command; to change labels on several issues, run 'issue update'
+once per issue.
+
 Examples:
   linear issue update ENG-123 --title "New title"
   linear issue update ENG-123 --priority 2
-  linear issue update ENG-123 --assignee self --state abc123`,
+  linear issue update ENG-123 --assignee self --state abc123
+  linear issue update ENG-123 --unset assignee --unset due-date
+  linear issue update ENG-123 --add-label needs-review --remove-label triage`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
 
+			cleared, err := resolveUnset(cmd, unset, clearable)
+			if err != nil {
+				return reportUnsetError(err, "linear issue update ENG-123 --unset assignee")
+			}
+
+			if len(labels) > 0 && (len(addLabels) > 0 || len(removeLabels) > 0) {
+				msg := "--label replaces all labels and cannot be combined with --add-label/--remove-label"
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			editingLabels := len(addLabels) > 0 || len(removeLabels) > 0
+			hasFieldUpdates := title != "" || description != "" || priority != 0 || estimate != 0 ||
+				assignee != "" || len(labels) > 0 || projectID != "" || stateID != "" ||
+				parentID != "" || dueDate != "" || cycleID != "" || milestoneID != "" ||
+				len(cleared) > 0
+
 			// Check that at least one field is provided
-			if title == "" && description == "" && priority == 0 && estimate == 0 &&
-				assignee == "" && len(labels) == 0 && projectID == "" && stateID == "" &&
-				parentID == "" && dueDate == "" && cycleID == "" && milestoneID == "" {
+			if !hasFieldUpdates && !editingLabels {
 				if IsHumanOutput() {
 					output.ErrorHuman("At least one field must be provided to update")
 					return nil
@@ -526,7 +565,7 @@ Examples:
 				return output.Error("MISSING_FIELD", "At least one field must be provided to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -541,12 +580,13 @@ Examples:
 			input := api.IssueUpdateInput{
 				Title:              title,
 				Description:        description,
-				ProjectID:          projectID,
+				ProjectID:          nullableField(cleared, "project", projectID),
 				StateID:            stateID,
-				ParentID:           parentID,
-				DueDate:            dueDate,
-				CycleID:            cycleID,
-				ProjectMilestoneID: milestoneID,
+				ParentID:           nullableField(cleared, "parent", parentID),
+				DueDate:            nullableField(cleared, "due-date", dueDate),
+				CycleID:            nullableField(cleared, "cycle", cycleID),
+				ProjectMilestoneID: nullableField(cleared, "milestone", milestoneID),
+				AssigneeID:         nullableField(cleared, "assignee", ""),
 			}
 
 			if priority > 0 {
@@ -568,9 +608,9 @@ Examples:
 						}
 						return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
 					}
-					input.AssigneeID = viewerID
+					input.AssigneeID = api.Value(viewerID)
 				} else {
-					input.AssigneeID = assignee
+					input.AssigneeID = api.Value(assignee)
 				}
 			}
 
@@ -578,6 +618,30 @@ Examples:
 				input.LabelIDs = labels
 			}
 
+			var labelChange *IssueLabelChange
+			if editingLabels {
+				change, ok, err := resolveIssueLabelChange(ctx, client, issueID, addLabels, removeLabels)
+				if !ok {
+					return err
+				}
+				labelChange = change
+				input.AddedLabelIDs = labelChange.addedIDs
+				input.RemovedLabelIDs = labelChange.removedIDs
+
+				if !hasFieldUpdates && len(input.AddedLabelIDs) == 0 && len(input.RemovedLabelIDs) == 0 {
+					if IsHumanOutput() {
+						output.HumanLn("Labels already up to date on %s", issueID)
+						return nil
+					}
+					return output.JSON(map[string]interface{}{
+						"success":   true,
+						"operation": "update",
+						"issue":     map[string]interface{}{"identifier": issueID},
+						"labels":    labelChange,
+					})
+				}
+			}
+
 			result, err := client.UpdateIssue(ctx, issueID, input)
 			if err != nil {
 				if IsHumanOutput() {
@@ -596,9 +660,20 @@ Examples:
 					"url":        result.URL,
 				},
 			}
+			if labelChange != nil {
+				response["labels"] = labelChange
+			}
 
 			if IsHumanOutput() {
 				output.SuccessHuman(fmt.Sprintf("Updated issue %s", result.Identifier))
+				if labelChange != nil {
+					if len(labelChange.Added) > 0 {
+						output.HumanLn("  Added labels: %s", strings.Join(labelChange.Added, ", "))
+					}
+					if len(labelChange.Removed) > 0 {
+						output.HumanLn("  Removed labels: %s", strings.Join(labelChange.Removed, ", "))
+					}
+				}
 			} else {
 				output.JSON(response)
 			}
@@ -619,6 +694,9 @@ Examples:
 	cmd.Flags().StringVar(&dueDate, "due-date", "", "New due date (YYYY-MM-DD)")
 	cmd.Flags().StringVar(&cycleID, "cycle", "", "New cycle ID")
 	cmd.Flags().StringVar(&milestoneID, "milestone", "", "New project milestone ID")
+	cmd.Flags().StringArrayVar(&addLabels, "add-label", nil, "Add a label by name, Group/Name, or ID (repeatable)")
+	cmd.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "Remove a label by name, Group/Name, or ID (repeatable)")
+	addUnsetFlag(cmd, &unset, clearable)
 
 	return cmd
 }
@@ -634,7 +712,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -694,7 +772,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -785,7 +863,7 @@ Examples:
 				relationType = "duplicate"
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -844,7 +922,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			relationID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -894,7 +972,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -934,13 +1012,137 @@ Examples:
 	return cmd
 }
 
+func newIssueHistoryCmd() *cobra.Command {
+	var (
+		since string
+		limit int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history <issue-id>",
+		Short: "View issue change history",
+		Long: `View the audit trail of an issue in chronological order.
+
+Each entry lists who made the change, when, and which fields changed
+(state, assignee, priority, labels, estimate, project, cycle).
+
+The --since filter accepts a date (2025-01-31), an RFC 3339 timestamp,
+or a relative duration (90m, 24h, 7d, 2w).
+
+Examples:
+  linear issue history ENG-123
+  linear issue history ENG-123 --since 7d
+  linear issue history ENG-123 --since 2025-01-01 --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			issueID := args[0]
+
+			if limit < 1 {
+				msg := "--limit must be at least 1"
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			var sinceTime time.Time
+			if since != "" {
+				t, err := parseSince(since)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(
+							err.Error(),
+							"Use a date, an RFC 3339 timestamp, or a duration like 24h or 7d",
+							"linear issue history ENG-123 --since 7d",
+							"linear issue history ENG-123 --since 2025-01-01",
+						)
+						return nil
+					}
+					return output.ErrorWithHint(
+						"INVALID_SINCE",
+						err.Error(),
+						"Use a date, an RFC 3339 timestamp, or a duration like 24h or 7d",
+						"linear issue history ENG-123 --since 7d",
+						"linear issue history ENG-123 --since 2025-01-01",
+					)
+				}
+				sinceTime = t
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			history, err := client.GetIssueHistory(ctx, issueID, sinceTime, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				printIssueHistoryHuman(history)
+			} else {
+				output.JSON(history)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "", "Only show changes after this date, timestamp, or duration (e.g., 7d)")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 100, "Maximum history entries to fetch")
+
+	return cmd
+}
+
+// parseSince parses a --since value: a date, an RFC 3339 timestamp, or a
+// relative duration such as 90m, 24h, 7d or 2w.
+func parseSince(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+
+	if len(value) > 1 {
+		unit := value[len(value)-1]
+		var n int
+		if _, err := fmt.Sscanf(value[:len(value)-1], "%d", &n); err == nil && n >= 0 {
+			switch unit {
+			case 'd':
+				return time.Now().Add(-time.Duration(n) * 24 * time.Hour), nil
+			case 'w':
+				return time.Now().Add(-time.Duration(n) * 7 * 24 * time.Hour), nil
+			}
+		}
+	}
+
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since value: %s", value)
+}
+
 func newIssueCommentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "comment",
 		Short: "Manage issue comments",
 	}
 
-	cmd.AddCommand(newIssueCommentCreateCmd())
+	cmd.AddCommand(honorAppActor(newIssueCommentCreateCmd()))
 	cmd.AddCommand(newIssueCommentListCmd())
 
 	return cmd
@@ -968,7 +1170,7 @@ Examples:
 				return output.Error("MISSING_BODY", "Comment body is required. Use --body flag.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -979,6 +1181,10 @@ Examples:
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
+			if ok, err := applyAppActor(client); !ok {
+				return err
+			}
+
 			comment, err := client.CreateComment(ctx, issueID, body)
 			if err != nil {
 				if IsHumanOutput() {
@@ -1023,7 +1229,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1274,6 +1480,57 @@ func printRelationsHuman(issue *api.IssueDetail) {
 	output.TableWithColors(headers, rows)
 }
 
+func printIssueHistoryHuman(history *api.IssueHistoryResponse) {
+	if len(history.History) == 0 {
+		output.HumanLn("No history for %s", history.Identifier)
+		return
+	}
+
+	output.HumanLn("History for %s:\n", history.Identifier)
+
+	for _, entry := range history.History {
+		actor := "Linear"
+		if entry.Actor != nil {
+			actor = entry.Actor.DisplayName
+		} else if entry.BotActor != "" {
+			actor = entry.BotActor
+		}
+
+		when := entry.CreatedAt
+		if t, err := time.Parse(time.RFC3339, entry.CreatedAt); err == nil {
+			when = display.FormatDateTime(t.Local())
+		}
+
+		output.HumanLn("%s  %s", output.Muted("%s", when), output.Bold("%s", actor))
+		for _, change := range entry.Changes {
+			if change.Field == "labels" {
+				if len(change.Added) > 0 {
+					output.HumanLn("  labels: +%s", strings.Join(change.Added, ", +"))
+				}
+				if len(change.Removed) > 0 {
+					output.HumanLn("  labels: -%s", strings.Join(change.Removed, ", -"))
+				}
+				continue
+			}
+
+			from := change.From
+			if from == "" {
+				from = output.Muted("none")
+			}
+			to := change.To
+			if to == "" {
+				to = output.Muted("none")
+			}
+			output.HumanLn("  %s: %s → %s", change.Field, from, to)
+		}
+	}
+
+	output.HumanLn("\n%d changes", history.Count)
+	if history.HasMore {
+		output.HumanLn("%s", output.Yellow("Older changes were not fetched; raise --limit to see more"))
+	}
+}
+
 func printCommentsHuman(comments []api.Comment) {
 	if len(comments) == 0 {
 		output.HumanLn("No comments")
@@ -1347,7 +1604,7 @@ Examples:
 				return output.Error("MISSING_URL", "Attachment URL is required. Use --url flag.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1406,7 +1663,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1452,7 +1709,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			attachmentID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1533,7 +1790,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1608,7 +1865,7 @@ Examples:
 
 			// Assign to current user if unassigned
 			if issue.Assignee == nil {
-				updateInput.AssigneeID = viewer.Viewer.ID
+				updateInput.AssigneeID = api.Value(viewer.Viewer.ID)
 			}
 
 			result, err := client.UpdateIssue(ctx, issue.ID, updateInput)
@@ -1665,7 +1922,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1704,7 +1961,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1743,7 +2000,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			issueID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
 	"github.com/juanbermudez/agent-linear-cli/internal/cache"
@@ -82,7 +84,7 @@ Examples:
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -221,7 +223,7 @@ Examples:
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -324,7 +326,7 @@ Examples:
 				return output.Error("MISSING_FIELD", "At least one field must be provided to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -384,7 +386,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			labelID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -568,3 +570,225 @@ func printLabelsHuman(labels *LabelsListResponse, teamKey string, plain bool) {
 	output.TableWithColors(headers, rows)
 	output.HumanLn("\n%d labels", labels.Count)
 }
+
+// IssueLabelChange is the resolved effect of --add-label/--remove-label
+type IssueLabelChange struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+
+	addedIDs   []string
+	removedIDs []string
+}
+
+// LabelConflict lists labels from one label group that would end up on the
+// same issue; Linear allows only one label per group
+type LabelConflict struct {
+	Group  string   `json:"group"`
+	Labels []string `json:"labels"`
+}
+
+// resolveLabelRef finds a label by ID, name, or "Group/Name"
+func resolveLabelRef(labels []api.AvailableLabel, ref string) (*api.AvailableLabel, error) {
+	var matches []api.AvailableLabel
+	for _, l := range labels {
+		if l.ID == ref {
+			return &l, nil
+		}
+		if strings.EqualFold(l.Name, ref) ||
+			(l.ParentName != "" && strings.EqualFold(l.ParentName+"/"+l.Name, ref)) {
+			matches = append(matches, l)
+		}
+	}
+
+	// Team labels shadow workspace labels of the same name
+	if len(matches) > 1 {
+		var teamMatches []api.AvailableLabel
+		for _, l := range matches {
+			if l.TeamID != "" {
+				teamMatches = append(teamMatches, l)
+			}
+		}
+		if len(teamMatches) > 0 {
+			matches = teamMatches
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("label not found: %s", ref)
+	case 1:
+		return &matches[0], nil
+	}
+
+	names := make([]string, len(matches))
+	for i, l := range matches {
+		names[i] = labelDisplayName(l)
+	}
+	return nil, fmt.Errorf("label %q is ambiguous: %s (use Group/Name or the label ID)", ref, strings.Join(names, ", "))
+}
+
+func labelDisplayName(l api.AvailableLabel) string {
+	if l.ParentName != "" {
+		return l.ParentName + "/" + l.Name
+	}
+	return l.Name
+}
+
+// labelFlagsError is a label change that resolves but can't be applied,
+// as opposed to a label reference that doesn't resolve
+type labelFlagsError struct {
+	msg string
+}
+
+func (e *labelFlagsError) Error() string {
+	return e.msg
+}
+
+// planIssueLabelChange resolves label references against the labels
+// available to the issue's team and checks the resulting label set for
+// label-group conflicts
+func planIssueLabelChange(current []api.IssueLabel, available []api.AvailableLabel, add, remove []string) (*IssueLabelChange, []LabelConflict, error) {
+	byID := map[string]api.AvailableLabel{}
+	for _, l := range available {
+		byID[l.ID] = l
+	}
+
+	final := map[string]bool{}
+	for _, l := range current {
+		final[l.ID] = true
+	}
+
+	change := &IssueLabelChange{Added: []string{}, Removed: []string{}}
+	removing := map[string]bool{}
+
+	for _, ref := range remove {
+		label, err := resolveLabelRef(available, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		removing[label.ID] = true
+		if final[label.ID] {
+			delete(final, label.ID)
+			change.removedIDs = append(change.removedIDs, label.ID)
+			change.Removed = append(change.Removed, labelDisplayName(*label))
+		}
+	}
+
+	adding := map[string]bool{}
+	for _, ref := range add {
+		label, err := resolveLabelRef(available, ref)
+		if err != nil {
+			return nil, nil, err
+		}
+		if label.IsGroup {
+			return nil, nil, &labelFlagsError{fmt.Sprintf("%s is a label group; add one of its labels instead", label.Name)}
+		}
+		if removing[label.ID] {
+			return nil, nil, &labelFlagsError{fmt.Sprintf("label %s is both added and removed", labelDisplayName(*label))}
+		}
+		adding[label.ID] = true
+		if !final[label.ID] {
+			final[label.ID] = true
+			change.addedIDs = append(change.addedIDs, label.ID)
+			change.Added = append(change.Added, labelDisplayName(*label))
+		}
+	}
+
+	// Only groups touched by an added label can newly conflict
+	groups := map[string][]string{}
+	for id := range final {
+		if l, ok := byID[id]; ok && l.ParentID != "" {
+			groups[l.ParentID] = append(groups[l.ParentID], id)
+		}
+	}
+
+	var conflicts []LabelConflict
+	for _, ids := range groups {
+		if len(ids) < 2 {
+			continue
+		}
+		touched := false
+		for _, id := range ids {
+			if adding[id] {
+				touched = true
+			}
+		}
+		if !touched {
+			continue
+		}
+		names := make([]string, len(ids))
+		for i, id := range ids {
+			names[i] = byID[id].Name
+		}
+		sort.Strings(names)
+		conflicts = append(conflicts, LabelConflict{Group: byID[ids[0]].ParentName, Labels: names})
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Group < conflicts[j].Group })
+
+	return change, conflicts, nil
+}
+
+// resolveIssueLabelChange loads the issue's labels and the labels available
+// to its team, then plans --add-label/--remove-label. Errors and conflicts
+// are already reported when ok is false; the caller should return err as-is.
+func resolveIssueLabelChange(ctx context.Context, client *api.Client, issueID string, add, remove []string) (*IssueLabelChange, bool, error) {
+	issue, err := client.GetIssue(ctx, issueID, false)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil, false, nil
+		}
+		return nil, false, output.Error("API_ERROR", err.Error())
+	}
+
+	available, err := client.GetAvailableLabels(ctx, issue.Team.ID)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil, false, nil
+		}
+		return nil, false, output.Error("API_ERROR", err.Error())
+	}
+
+	change, conflicts, err := planIssueLabelChange(issue.Labels, available, add, remove)
+	if err != nil {
+		code := "LABEL_NOT_FOUND"
+		hint := "List available labels with 'linear label list --team " + issue.Team.Key + "'"
+		var flagsErr *labelFlagsError
+		if errors.As(err, &flagsErr) {
+			code = "INVALID_FLAGS"
+			hint = "Pass each label to only one of --add-label and --remove-label, and add labels rather than label groups"
+		}
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint(err.Error(), hint)
+			return nil, false, nil
+		}
+		return nil, false, output.ErrorWithHint(code, err.Error(), hint)
+	}
+
+	if len(conflicts) > 0 {
+		parts := make([]string, len(conflicts))
+		for i, c := range conflicts {
+			parts[i] = fmt.Sprintf("%s (%s)", c.Group, strings.Join(c.Labels, ", "))
+		}
+		msg := "Only one label per group is allowed: " + strings.Join(parts, "; ")
+		hint := "Remove the existing label from the group in the same command with --remove-label"
+		usage := "linear issue update " + issueID + " --remove-label <old> --add-label <new>"
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint(msg, hint, usage)
+			return nil, false, nil
+		}
+		return nil, false, output.JSON(map[string]interface{}{
+			"success": false,
+			"error": map[string]interface{}{
+				"code":      "LABEL_CONFLICT",
+				"message":   msg,
+				"hint":      hint,
+				"usage":     []string{usage},
+				"conflicts": conflicts,
+			},
+		})
+	}
+
+	return change, true, nil
+}
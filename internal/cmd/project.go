@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -35,14 +36,29 @@ Examples:
 	cmd.AddCommand(newProjectSearchCmd())
 	cmd.AddCommand(newProjectMilestoneCmd())
 	cmd.AddCommand(newProjectUpdateStatusCmd())
+	cmd.AddCommand(newProjectRelateCmd())
+	cmd.AddCommand(newProjectUnrelateCmd())
+	cmd.AddCommand(newProjectRelationsCmd())
+	cmd.AddCommand(newProjectLabelCmd())
+	cmd.AddCommand(newProjectMemberCmd())
+	cmd.AddCommand(honorAppActor(newProjectReportCmd()))
 
 	return cmd
 }
 
 func newProjectListCmd() *cobra.Command {
 	var (
-		teamKey string
-		limit   int
+		teamKey      string
+		statusTypes  []string
+		lead         string
+		health       []string
+		initiativeID string
+		labels       []string
+		targetAfter  string
+		targetBefore string
+		member       string
+		sortBy       string
+		limit        int
 	)
 
 	cmd := &cobra.Command{
@@ -50,12 +66,40 @@ func newProjectListCmd() *cobra.Command {
 		Short: "List projects",
 		Long: `List projects with optional filters.
 
+Status types: backlog, planned, started, paused, completed, canceled
+Health values: onTrack, atRisk, offTrack
+Sort orders: updated (default), target-date, progress
+
+Filters combine with AND; repeated --status, --health and --label values
+match any of the given values.
+
 Examples:
   linear project list
   linear project list --team ENG
-  linear project list --limit 20`,
+  linear project list --status started --health atRisk --health offTrack
+  linear project list --lead self --sort target-date
+  linear project list --label "Q3" --initiative <initiative-id>
+  linear project list --target-after 2025-07-01 --target-before 2025-09-30
+  linear project list --member self --limit 20`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			if limit < 1 {
+				msg := "--limit must be at least 1"
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			if err := validateProjectListFlags(statusTypes, health, targetAfter, targetBefore, sortBy); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", err.Error())
+			}
+
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -66,8 +110,15 @@ Examples:
 				return output.Error("AUTH_ERROR", err.Error())
 			}
 
+			filter := api.ProjectFilter{
+				StatusTypes:  statusTypes,
+				Health:       health,
+				InitiativeID: initiativeID,
+				TargetAfter:  targetAfter,
+				TargetBefore: targetBefore,
+			}
+
 			// Resolve team key to ID if provided
-			var teamID string
 			if teamKey != "" {
 				team, err := client.GetTeamByKey(ctx, teamKey)
 				if err != nil {
@@ -84,10 +135,38 @@ Examples:
 					}
 					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
 				}
-				teamID = team.ID
+				filter.TeamID = team.ID
+			}
+
+			// Resolve "self" for lead and member filters
+			if lead != "" {
+				if filter.LeadID, err = resolveUserRef(ctx, client, lead); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+			}
+			if member != "" {
+				if filter.MemberID, err = resolveUserRef(ctx, client, member); err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+			}
+
+			if len(labels) > 0 {
+				labelIDs, ok, err := resolveProjectLabelIDs(ctx, client, labels)
+				if !ok {
+					return err
+				}
+				filter.LabelIDs = labelIDs
 			}
 
-			projects, err := client.GetProjects(ctx, teamID, limit)
+			projects, err := client.GetProjects(ctx, filter, limit, sortBy)
 			if err != nil {
 				if IsHumanOutput() {
 					output.ErrorHuman(err.Error())
@@ -107,11 +186,57 @@ Examples:
 	}
 
 	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Filter by team key (e.g., ENG)")
+	cmd.Flags().StringSliceVarP(&statusTypes, "status", "s", nil, "Filter by status type (backlog, planned, started, paused, completed, canceled)")
+	cmd.Flags().StringVar(&lead, "lead", "", "Filter by lead user ID (use 'self' for yourself)")
+	cmd.Flags().StringSliceVar(&health, "health", nil, "Filter by health (onTrack, atRisk, offTrack)")
+	cmd.Flags().StringVar(&initiativeID, "initiative", "", "Filter by initiative ID")
+	cmd.Flags().StringArrayVar(&labels, "label", nil, "Filter by project label name or ID (can be specified multiple times)")
+	cmd.Flags().StringVar(&targetAfter, "target-after", "", "Only projects with a target date on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&targetBefore, "target-before", "", "Only projects with a target date on or before this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&member, "member", "", "Filter by member user ID (use 'self' for yourself)")
+	cmd.Flags().StringVar(&sortBy, "sort", "updated", "Sort order (updated, target-date, progress); target-date and progress load all matching projects before applying --limit")
 	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum projects to return")
 
 	return cmd
 }
 
+// validateProjectListFlags checks enumerated and date filter values before
+// any API calls are made
+func validateProjectListFlags(statusTypes, health []string, targetAfter, targetBefore, sortBy string) error {
+	validStatus := []string{"backlog", "planned", "started", "paused", "completed", "canceled"}
+	for _, s := range statusTypes {
+		if !containsString(validStatus, s) {
+			return fmt.Errorf("invalid status type %q (valid: %s)", s, strings.Join(validStatus, ", "))
+		}
+	}
+
+	validHealth := []string{"onTrack", "atRisk", "offTrack"}
+	for _, h := range health {
+		if !containsString(validHealth, h) {
+			return fmt.Errorf("invalid health %q (valid: %s)", h, strings.Join(validHealth, ", "))
+		}
+	}
+
+	for flag, value := range map[string]string{"target-after": targetAfter, "target-before": targetBefore} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return fmt.Errorf("invalid --%s date %q (use YYYY-MM-DD)", flag, value)
+		}
+	}
+	if targetAfter != "" && targetBefore != "" && targetAfter > targetBefore {
+		return fmt.Errorf("--target-after %s is later than --target-before %s", targetAfter, targetBefore)
+	}
+
+	validSort := []string{"updated", "target-date", "progress"}
+	if !containsString(validSort, sortBy) {
+		return fmt.Errorf("invalid sort order %q (valid: %s)", sortBy, strings.Join(validSort, ", "))
+	}
+
+	return nil
+}
+
 func newProjectViewCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "view <project-id>",
@@ -124,7 +249,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -152,6 +277,16 @@ Examples:
 				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
 			}
 
+			relations, err := client.GetProjectRelations(ctx, project.ID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+			project.Relations = relations
+
 			if IsHumanOutput() {
 				printProjectDetailHuman(project)
 			} else {
@@ -178,6 +313,8 @@ func newProjectCreateCmd() *cobra.Command {
 		startDate   string
 		targetDate  string
 		priority    int
+		labels      []string
+		members     []string
 	)
 
 	cmd := &cobra.Command{
@@ -188,7 +325,9 @@ func newProjectCreateCmd() *cobra.Command {
 Examples:
   linear project create --name "Q1 Feature Development" --team ENG
   linear project create --name "Auth Refactor" --team ENG --team BACKEND
-  linear project create --name "Feature" --description "Description here" --target-date 2025-03-01`,
+  linear project create --name "Feature" --description "Description here" --target-date 2025-03-01
+  linear project create --name "Billing v2" --team ENG --label Q3 --label "Bets/Growth"
+  linear project create --name "Billing v2" --team ENG --lead <user-id> --member self --member jane@example.com`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if name == "" {
 				if IsHumanOutput() {
@@ -232,7 +371,7 @@ Examples:
 				}
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -290,6 +429,24 @@ Examples:
 				input.Priority = &priority
 			}
 
+			if len(labels) > 0 {
+				labelIDs, ok, err := resolveProjectLabelIDs(ctx, client, labels)
+				if !ok {
+					return err
+				}
+				input.LabelIDs = labelIDs
+			}
+
+			if len(members) > 0 {
+				users, ok, err := resolveUsers(ctx, client, members)
+				if !ok {
+					return err
+				}
+				for _, u := range users {
+					input.MemberIDs = append(input.MemberIDs, u.ID)
+				}
+			}
+
 			project, err := client.CreateProject(ctx, input)
 			if err != nil {
 				if IsHumanOutput() {
@@ -326,6 +483,8 @@ Examples:
 	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date (YYYY-MM-DD)")
 	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date (YYYY-MM-DD)")
 	cmd.Flags().IntVar(&priority, "priority", 0, "Project priority (0-4)")
+	cmd.Flags().StringArrayVar(&labels, "label", nil, "Project label name or ID (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&members, "member", nil, "Project member email, display name, or ID; 'self' for yourself (can be specified multiple times)")
 
 	return cmd
 }
@@ -342,23 +501,35 @@ func newProjectUpdateCmd() *cobra.Command {
 		startDate   string
 		targetDate  string
 		priority    int
+		unset       []string
 	)
 
+	clearable := []string{"lead", "icon", "color", "start-date", "target-date"}
+
 	cmd := &cobra.Command{
 		Use:   "update <project-id>",
 		Short: "Update a project",
 		Long: `Update an existing project.
 
+Use --unset to clear a field: lead, icon, color, start-date, target-date.
+
 Examples:
   linear project update abc123 --name "New Name"
   linear project update abc123 --description "Updated description"
-  linear project update abc123 --target-date 2025-06-01`,
+  linear project update abc123 --target-date 2025-06-01
+  linear project update abc123 --unset lead --unset target-date`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
 
+			cleared, err := resolveUnset(cmd, unset, clearable)
+			if err != nil {
+				return reportUnsetError(err, "linear project update abc123 --unset lead")
+			}
+
 			// Check if at least one field is being updated
-			if !cmd.Flags().Changed("name") &&
+			if len(cleared) == 0 &&
+				!cmd.Flags().Changed("name") &&
 				!cmd.Flags().Changed("description") &&
 				!cmd.Flags().Changed("content") &&
 				!cmd.Flags().Changed("status-id") &&
@@ -375,7 +546,7 @@ Examples:
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -400,21 +571,11 @@ Examples:
 			if cmd.Flags().Changed("status-id") {
 				input.StatusID = statusID
 			}
-			if cmd.Flags().Changed("lead") {
-				input.LeadID = leadID
-			}
-			if cmd.Flags().Changed("icon") {
-				input.Icon = icon
-			}
-			if cmd.Flags().Changed("color") {
-				input.Color = color
-			}
-			if cmd.Flags().Changed("start-date") {
-				input.StartDate = startDate
-			}
-			if cmd.Flags().Changed("target-date") {
-				input.TargetDate = targetDate
-			}
+			input.LeadID = nullableField(cleared, "lead", leadID)
+			input.Icon = nullableField(cleared, "icon", icon)
+			input.Color = nullableField(cleared, "color", color)
+			input.StartDate = nullableField(cleared, "start-date", startDate)
+			input.TargetDate = nullableField(cleared, "target-date", targetDate)
 			if cmd.Flags().Changed("priority") {
 				input.Priority = &priority
 			}
@@ -452,6 +613,7 @@ Examples:
 	cmd.Flags().StringVar(&startDate, "start-date", "", "Project start date (YYYY-MM-DD)")
 	cmd.Flags().StringVar(&targetDate, "target-date", "", "Project target date (YYYY-MM-DD)")
 	cmd.Flags().IntVar(&priority, "priority", 0, "Project priority (0-4)")
+	addUnsetFlag(cmd, &unset, clearable)
 
 	return cmd
 }
@@ -467,7 +629,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -515,7 +677,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -572,7 +734,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -684,7 +846,7 @@ func newProjectMilestoneListCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -742,7 +904,7 @@ Examples:
 				return output.Error("MISSING_NAME", "Milestone name is required")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -807,7 +969,7 @@ func newProjectMilestoneUpdateCmd() *cobra.Command {
 				return output.Error("MISSING_FIELDS", "At least one field must be specified to update")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -866,7 +1028,7 @@ func newProjectMilestoneDeleteCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			milestoneID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -914,7 +1076,7 @@ Examples:
 	}
 
 	cmd.AddCommand(newProjectUpdateStatusListCmd())
-	cmd.AddCommand(newProjectUpdateStatusCreateCmd())
+	cmd.AddCommand(honorAppActor(newProjectUpdateStatusCreateCmd()))
 
 	return cmd
 }
@@ -928,7 +1090,7 @@ func newProjectUpdateStatusListCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			projectID := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -991,7 +1153,7 @@ Examples:
 				return output.Error("MISSING_BODY", "Update body is required")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -1007,6 +1169,10 @@ Examples:
 				healthPtr = &health
 			}
 
+			if ok, err := applyAppActor(client); !ok {
+				return err
+			}
+
 			update, err := client.CreateProjectUpdate(ctx, projectID, body, healthPtr)
 			if err != nil {
 				if IsHumanOutput() {
@@ -1045,7 +1211,7 @@ func printProjectsHuman(projects *api.ProjectsResponse) {
 		return
 	}
 
-	headers := []string{"NAME", "STATUS", "PROGRESS", "LEAD", "TEAMS", "TARGET", "ID"}
+	headers := []string{"NAME", "STATUS", "PROGRESS", "HEALTH", "LEAD", "TEAMS", "TARGET", "ID"}
 	rows := make([][]string, len(projects.Projects))
 
 	for i, p := range projects.Projects {
@@ -1079,6 +1245,7 @@ func printProjectsHuman(projects *api.ProjectsResponse) {
 			display.Truncate(p.Name, 40),
 			statusName,
 			progress,
+			formatProjectHealth(p.Health),
 			leadName,
 			teamsStr,
 			targetDate,
@@ -1108,6 +1275,18 @@ func printProjectDetailHuman(p *api.ProjectDetail) {
 		output.HumanLn("Lead: %s", p.Lead.DisplayName)
 	}
 
+	if len(p.Labels) > 0 {
+		output.HumanLn("Labels: %s", formatProjectLabels(p.Labels))
+	}
+
+	if len(p.Members) > 0 {
+		names := make([]string, len(p.Members))
+		for i, m := range p.Members {
+			names[i] = m.DisplayName
+		}
+		output.HumanLn("Members: %s", strings.Join(names, ", "))
+	}
+
 	if len(p.Teams) > 0 {
 		teamNames := make([]string, len(p.Teams))
 		for i, t := range p.Teams {
@@ -1124,6 +1303,11 @@ func printProjectDetailHuman(p *api.ProjectDetail) {
 		output.HumanLn("Target Date: %s", p.TargetDate)
 	}
 
+	if p.Relations != nil && (len(p.Relations.Upstream) > 0 || len(p.Relations.Downstream) > 0) {
+		output.HumanLn("")
+		printProjectRelationsHuman(p.Relations)
+	}
+
 	output.HumanLn("")
 	output.HumanLn("URL: %s", p.URL)
 	output.HumanLn("ID: %s", output.Muted("%s", p.ID))
@@ -1190,3 +1374,995 @@ func printProjectUpdatesHuman(updates *api.ProjectUpdatesResponse) {
 
 	output.HumanLn("%d updates", updates.Count)
 }
+
+func newProjectRelateCmd() *cobra.Command {
+	var (
+		blocks           bool
+		blockedBy        bool
+		milestoneID      string
+		relatedMilestone string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "relate <project-id> <related-project-id>",
+		Short: "Create a project dependency",
+		Long: `Create a dependency between two projects.
+
+Direction (specify one):
+  --blocks      Project blocks the related project (default)
+  --blocked-by  Project is blocked by the related project
+
+By default the blocking project's end is anchored to the blocked project's
+start. Use --milestone and --related-milestone to anchor either side to a
+milestone instead (IDs from 'linear project milestone list').
+
+Examples:
+  linear project relate <api-project> <mobile-project> --blocks
+  linear project relate <mobile-project> <api-project> --blocked-by
+  linear project relate <api-project> <mobile-project> --milestone <beta-milestone>`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if blocks && blockedBy {
+				if IsHumanOutput() {
+					output.ErrorHuman("Specify only one of --blocks or --blocked-by")
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", "Specify only one of --blocks or --blocked-by")
+			}
+
+			// The API models "project blocks relatedProject"; swap sides
+			// for --blocked-by
+			input := api.ProjectRelationCreateInput{
+				ProjectID:                 args[0],
+				RelatedProjectID:          args[1],
+				ProjectMilestoneID:        milestoneID,
+				RelatedProjectMilestoneID: relatedMilestone,
+			}
+			relationType := "blocks"
+			if blockedBy {
+				relationType = "blocked_by"
+				input.ProjectID, input.RelatedProjectID = input.RelatedProjectID, input.ProjectID
+				input.ProjectMilestoneID, input.RelatedProjectMilestoneID = input.RelatedProjectMilestoneID, input.ProjectMilestoneID
+			}
+
+			input.AnchorType = "end"
+			if input.ProjectMilestoneID != "" {
+				input.AnchorType = "milestone"
+			}
+			input.RelatedAnchorType = "start"
+			if input.RelatedProjectMilestoneID != "" {
+				input.RelatedAnchorType = "milestone"
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			relation, err := client.CreateProjectRelation(ctx, input)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("%s now blocks %s", relation.Project.Name, relation.RelatedProject.Name))
+				output.HumanLn("  Relation ID: %s", output.Muted("%s", relation.ID))
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "relate",
+				"type":      relationType,
+				"relation":  relation,
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&blocks, "blocks", false, "Project blocks the related project (default)")
+	cmd.Flags().BoolVar(&blockedBy, "blocked-by", false, "Project is blocked by the related project")
+	cmd.Flags().StringVar(&milestoneID, "milestone", "", "Anchor on this milestone of the first project")
+	cmd.Flags().StringVar(&relatedMilestone, "related-milestone", "", "Anchor on this milestone of the related project")
+
+	return cmd
+}
+
+func newProjectUnrelateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unrelate <relation-id>",
+		Short: "Remove a project dependency",
+		Long: `Remove a dependency between projects.
+
+Use 'project relations <project-id>' to find relation IDs.
+
+Examples:
+  linear project unrelate <relation-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			relationID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			if err := client.DeleteProjectRelation(ctx, relationID); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Removed project dependency")
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"success":    true,
+				"operation":  "unrelate",
+				"relationId": relationID,
+			})
+		},
+	}
+
+	return cmd
+}
+
+func newProjectRelationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relations <project-id>",
+		Short: "View project dependencies",
+		Long: `List the projects blocking this project (upstream) and the projects it
+blocks (downstream), with their health.
+
+Examples:
+  linear project relations <project-id>
+  linear project relations <project-id> --human`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			relations, err := client.GetProjectRelations(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				if len(relations.Upstream) == 0 && len(relations.Downstream) == 0 {
+					output.HumanLn("No project dependencies")
+					return nil
+				}
+				printProjectRelationsHuman(relations)
+				return nil
+			}
+
+			return output.JSON(relations)
+		},
+	}
+
+	return cmd
+}
+
+func printProjectRelationsHuman(relations *api.ProjectRelationsResponse) {
+	if len(relations.Upstream) > 0 {
+		output.HumanLn("Blocked by:")
+		for _, r := range relations.Upstream {
+			output.HumanLn("  • %s %s  %s → %s  %s",
+				r.Project.Name,
+				formatProjectHealth(r.Project.Health),
+				formatRelationAnchor(r.AnchorType, r.ProjectMilestone),
+				formatRelationAnchor(r.RelatedAnchorType, r.RelatedProjectMilestone),
+				output.Muted("%s", r.ID),
+			)
+		}
+	}
+
+	if len(relations.Downstream) > 0 {
+		output.HumanLn("Blocks:")
+		for _, r := range relations.Downstream {
+			output.HumanLn("  • %s %s  %s → %s  %s",
+				r.RelatedProject.Name,
+				formatProjectHealth(r.RelatedProject.Health),
+				formatRelationAnchor(r.AnchorType, r.ProjectMilestone),
+				formatRelationAnchor(r.RelatedAnchorType, r.RelatedProjectMilestone),
+				output.Muted("%s", r.ID),
+			)
+		}
+	}
+}
+
+// formatProjectHealth renders a project health value with color
+func formatProjectHealth(health string) string {
+	switch health {
+	case "onTrack":
+		return output.Green("[on track]")
+	case "atRisk":
+		return output.Yellow("[at risk]")
+	case "offTrack":
+		return output.Red("[off track]")
+	default:
+		return output.Muted("[no update]")
+	}
+}
+
+// formatRelationAnchor describes where a dependency attaches to a project
+func formatRelationAnchor(anchorType string, milestone *api.IssueMilestone) string {
+	if anchorType == "milestone" && milestone != nil {
+		return "milestone " + milestone.Name
+	}
+	return anchorType
+}
+
+func newProjectLabelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label",
+		Short: "Manage project labels",
+		Long: `Add or remove labels on a project.
+
+Labels are matched by ID, name, or "Group/Name" against the workspace's
+project labels.`,
+	}
+
+	cmd.AddCommand(newProjectLabelChangeCmd("add"))
+	cmd.AddCommand(newProjectLabelChangeCmd("remove"))
+
+	return cmd
+}
+
+// newProjectLabelChangeCmd builds `project label add` and `project label
+// remove`, which differ only in the mutation they run
+func newProjectLabelChangeCmd(action string) *cobra.Command {
+	short := "Add labels to a project"
+	if action == "remove" {
+		short = "Remove labels from a project"
+	}
+
+	cmd := &cobra.Command{
+		Use:   action + " <project-id> <label>...",
+		Short: short,
+		Long: fmt.Sprintf(`%s.
+
+Examples:
+  linear project label %s <project-id> Q3
+  linear project label %s <project-id> "Bets/Growth" <label-id>`, short, action, action),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			labelIDs, ok, err := resolveProjectLabelIDs(ctx, client, args[1:])
+			if !ok {
+				return err
+			}
+
+			var labels []api.ProjectLabel
+			for _, labelID := range labelIDs {
+				if action == "add" {
+					labels, err = client.AddProjectLabel(ctx, projectID, labelID)
+				} else {
+					labels, err = client.RemoveProjectLabel(ctx, projectID, labelID)
+				}
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+			}
+
+			if IsHumanOutput() {
+				if action == "add" {
+					output.SuccessHuman(fmt.Sprintf("Added %d label(s)", len(labelIDs)))
+				} else {
+					output.SuccessHuman(fmt.Sprintf("Removed %d label(s)", len(labelIDs)))
+				}
+				if len(labels) > 0 {
+					output.HumanLn("  Labels: %s", formatProjectLabels(labels))
+				} else {
+					output.HumanLn("  Labels: -")
+				}
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "label-" + action,
+				"projectId": projectID,
+				"labels":    labels,
+			})
+		},
+	}
+
+	return cmd
+}
+
+// resolveProjectLabelIDs resolves project label references to IDs. On
+// failure it reports the error and returns ok=false.
+func resolveProjectLabelIDs(ctx context.Context, client *api.Client, refs []string) ([]string, bool, error) {
+	available, err := client.GetProjectLabels(ctx)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil, false, nil
+		}
+		return nil, false, output.Error("API_ERROR", err.Error())
+	}
+
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		label, err := resolveLabelRef(available, ref)
+		if err == nil && label.IsGroup {
+			err = fmt.Errorf("%q is a label group; use one of its labels", ref)
+		}
+		if err != nil {
+			hint := "Project labels are managed in Linear's workspace settings"
+			if IsHumanOutput() {
+				output.ErrorHumanWithHint(err.Error(), hint)
+				return nil, false, nil
+			}
+			return nil, false, output.ErrorWithHint("LABEL_NOT_FOUND", err.Error(), hint)
+		}
+		ids = append(ids, label.ID)
+	}
+
+	return ids, true, nil
+}
+
+func formatProjectLabels(labels []api.ProjectLabel) string {
+	names := make([]string, len(labels))
+	for i, l := range labels {
+		names[i] = l.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func newProjectMemberCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Manage project members",
+		Long: `List, add, or remove project members.
+
+Users can be given by email, display name, name, or ID, or 'self' for
+yourself.`,
+	}
+
+	cmd.AddCommand(newProjectMemberListCmd())
+	cmd.AddCommand(newProjectMemberChangeCmd("add"))
+	cmd.AddCommand(newProjectMemberChangeCmd("remove"))
+
+	return cmd
+}
+
+func newProjectMemberListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <project-id>",
+		Short: "List project members",
+		Long: `List the members of a project.
+
+Examples:
+  linear project member list <project-id>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			members, err := client.GetProjectMembers(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				printProjectMembersHuman(members)
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"projectId": projectID,
+				"members":   members,
+				"count":     len(members),
+			})
+		},
+	}
+
+	return cmd
+}
+
+// newProjectMemberChangeCmd builds `project member add` and `project member
+// remove`, which differ only in how the member set is changed
+func newProjectMemberChangeCmd(action string) *cobra.Command {
+	short := "Add members to a project"
+	if action == "remove" {
+		short = "Remove members from a project"
+	}
+
+	cmd := &cobra.Command{
+		Use:   action + " <project-id> <user>...",
+		Short: short,
+		Long: fmt.Sprintf(`%s.
+
+Linear only accepts the whole member list, so this reads the current
+members, changes them, and writes the list back. A change someone else
+makes between the read and the write is overwritten; one made just after
+is reported as CONFLICT. Avoid running member changes on the same project
+concurrently.
+
+Examples:
+  linear project member %s <project-id> jane@example.com
+  linear project member %s <project-id> self "Sam Lee"`, short, action, action),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			users, ok, err := resolveUsers(ctx, client, args[1:])
+			if !ok {
+				return err
+			}
+
+			current, err := client.GetProjectMembers(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			memberIDs := make([]string, 0, len(current)+len(users))
+			for _, m := range current {
+				memberIDs = append(memberIDs, m.ID)
+			}
+
+			changed := []string{}
+			for _, u := range users {
+				isMember := containsString(memberIDs, u.ID)
+				switch {
+				case action == "add" && !isMember:
+					memberIDs = append(memberIDs, u.ID)
+					changed = append(changed, u.DisplayName)
+				case action == "remove" && isMember:
+					memberIDs = removeString(memberIDs, u.ID)
+					changed = append(changed, u.DisplayName)
+				}
+			}
+
+			members := current
+			if len(changed) > 0 {
+				members, err = client.SetProjectMembers(ctx, projectID, memberIDs)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+
+				// SetProjectMembers reads the list back; anything else in it
+				// means another change landed right after this one
+				if !sameProjectMembers(members, memberIDs) {
+					msg := "Project members were changed by someone else during this update, so one of the changes may be lost"
+					hint := "Check the member list and repeat any missing change"
+					usage := "linear project member list " + projectID
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(msg, hint, usage)
+						return nil
+					}
+					return output.ErrorWithHint("CONFLICT", msg, hint, usage)
+				}
+			}
+
+			if IsHumanOutput() {
+				switch {
+				case len(changed) == 0:
+					output.HumanLn("No changes")
+				case action == "add":
+					output.SuccessHuman(fmt.Sprintf("Added %s", strings.Join(changed, ", ")))
+				default:
+					output.SuccessHuman(fmt.Sprintf("Removed %s", strings.Join(changed, ", ")))
+				}
+				return nil
+			}
+
+			return output.JSON(map[string]interface{}{
+				"success":   true,
+				"operation": "member-" + action,
+				"projectId": projectID,
+				"changed":   len(changed) > 0,
+				"members":   members,
+			})
+		},
+	}
+
+	return cmd
+}
+
+// sameProjectMembers reports whether members are exactly the users in ids
+func sameProjectMembers(members []api.ProjectMember, ids []string) bool {
+	if len(members) != len(ids) {
+		return false
+	}
+	for _, m := range members {
+		if !containsString(ids, m.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+func removeString(values []string, s string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != s {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func printProjectMembersHuman(members []api.ProjectMember) {
+	if len(members) == 0 {
+		output.HumanLn("No members")
+		return
+	}
+
+	headers := []string{"NAME", "EMAIL", "ID"}
+	rows := make([][]string, len(members))
+	for i, m := range members {
+		rows[i] = []string{
+			m.DisplayName,
+			m.Email,
+			output.Muted("%s", m.ID),
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d members", len(members))
+}
+
+// ProjectReport summarizes a project's issues for a status update
+type ProjectReport struct {
+	Project struct {
+		ID         string  `json:"id"`
+		Name       string  `json:"name"`
+		URL        string  `json:"url"`
+		Progress   float64 `json:"progress"`
+		TargetDate string  `json:"targetDate,omitempty"`
+	} `json:"project"`
+	GeneratedAt   string                   `json:"generatedAt"`
+	Total         int                      `json:"total"`
+	ByStateType   map[string]int           `json:"byStateType"`
+	ThisWeek      ProjectReportWindow      `json:"thisWeek"`
+	LastWeek      ProjectReportWindow      `json:"lastWeek"`
+	Milestones    []ProjectReportMilestone `json:"milestones"`
+	Overdue       []api.ProjectIssue       `json:"overdue"`
+	Blocked       []api.ProjectIssue       `json:"blocked"`
+	Completed     []api.ProjectIssue       `json:"completedThisWeek"`
+	Health        string                   `json:"suggestedHealth"`
+	HealthReasons []string                 `json:"healthReasons"`
+	Body          string                   `json:"body"`
+}
+
+// ProjectReportWindow is the scope completed in a 7-day window
+type ProjectReportWindow struct {
+	Since  string  `json:"since"`
+	Issues int     `json:"issues"`
+	Points float64 `json:"points"`
+}
+
+// ProjectReportMilestone is a milestone's issue progress
+type ProjectReportMilestone struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	TargetDate string  `json:"targetDate,omitempty"`
+	Done       int     `json:"done"`
+	Total      int     `json:"total"`
+	Progress   float64 `json:"progress"`
+	Overdue    bool    `json:"overdue"`
+}
+
+func newProjectReportCmd() *cobra.Command {
+	var (
+		post   bool
+		health string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "report <project-id>",
+		Short: "Draft a project status update",
+		Long: `Summarize a project's issues and draft a Markdown status update.
+
+The report covers issue counts by state type, scope completed in the last 7
+days compared with the 7 days before, milestone progress, overdue issues,
+and issues blocked by open issues. It suggests a health:
+
+  offTrack  the project or a milestone is past its target date with open issues
+  atRisk    there are overdue or blocked issues, or nothing was completed in
+            the last 7 days while work is in progress
+  onTrack   otherwise
+
+Use --post to publish the draft as a project status update with the
+suggested health (or --health to override it).
+
+Examples:
+  linear project report <project-id>
+  linear project report <project-id> --human
+  linear project report <project-id> --post
+  linear project report <project-id> --post --health atRisk`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			projectID := args[0]
+
+			if health != "" && !containsString([]string{"onTrack", "atRisk", "offTrack"}, health) {
+				msg := fmt.Sprintf("invalid health %q (valid: onTrack, atRisk, offTrack)", health)
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+			if GetAppActor() != nil && !post {
+				return rejectAppActor(cmd)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			project, err := client.GetProject(ctx, projectID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+			if project == nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(fmt.Sprintf("Project '%s' not found", projectID))
+					return nil
+				}
+				return output.Error("NOT_FOUND", fmt.Sprintf("Project '%s' not found", projectID))
+			}
+
+			issues, err := client.GetProjectIssues(ctx, project.ID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			milestones, err := client.GetProjectMilestones(ctx, project.ID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			report := buildProjectReport(project, issues, milestones.Milestones, time.Now())
+			if health != "" {
+				report.Health = health
+			}
+
+			var update *api.ProjectUpdate
+			if post {
+				if ok, err := applyAppActor(client); !ok {
+					return err
+				}
+
+				update, err = client.CreateProjectUpdate(ctx, project.ID, report.Body, &report.Health)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+			}
+
+			if IsHumanOutput() {
+				output.HumanLn("%s", report.Body)
+				output.HumanLn("")
+				output.HumanLn("Suggested health: %s", formatProjectHealth(report.Health))
+				for _, reason := range report.HealthReasons {
+					output.HumanLn("  • %s", reason)
+				}
+				if update != nil {
+					output.HumanLn("")
+					output.SuccessHuman("Status update posted")
+					output.HumanLn("  ID: %s", update.ID)
+				}
+				return nil
+			}
+
+			result := map[string]interface{}{
+				"success": true,
+				"report":  report,
+				"posted":  update != nil,
+			}
+			if update != nil {
+				result["update"] = update
+			}
+			return output.JSON(result)
+		},
+	}
+
+	cmd.Flags().BoolVar(&post, "post", false, "Post the draft as a project status update")
+	cmd.Flags().StringVar(&health, "health", "", "Override the suggested health (onTrack, atRisk, offTrack)")
+
+	return cmd
+}
+
+// buildProjectReport aggregates a project's issues and milestones as of
+// now, and drafts the update body
+func buildProjectReport(project *api.ProjectDetail, issues []api.ProjectIssue, milestones []api.Milestone, now time.Time) *ProjectReport {
+	report := &ProjectReport{
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+		Total:       len(issues),
+		ByStateType: map[string]int{},
+		Milestones:  []ProjectReportMilestone{},
+		Overdue:     []api.ProjectIssue{},
+		Blocked:     []api.ProjectIssue{},
+		Completed:   []api.ProjectIssue{},
+	}
+	report.Project.ID = project.ID
+	report.Project.Name = project.Name
+	report.Project.URL = project.URL
+	report.Project.Progress = project.Progress
+	report.Project.TargetDate = project.TargetDate
+
+	today := now.Format("2006-01-02")
+	thisWeek := now.AddDate(0, 0, -7)
+	lastWeek := now.AddDate(0, 0, -14)
+	report.ThisWeek.Since = thisWeek.UTC().Format(time.RFC3339)
+	report.LastWeek.Since = lastWeek.UTC().Format(time.RFC3339)
+
+	byMilestone := map[string]*ProjectReportMilestone{}
+	for _, m := range milestones {
+		report.Milestones = append(report.Milestones, ProjectReportMilestone{
+			ID:         m.ID,
+			Name:       m.Name,
+			TargetDate: m.TargetDate,
+		})
+	}
+	for i := range report.Milestones {
+		byMilestone[report.Milestones[i].ID] = &report.Milestones[i]
+	}
+
+	open := 0
+	for _, issue := range issues {
+		stateType := issue.State.Type
+		report.ByStateType[stateType]++
+		done := stateType == "completed" || stateType == "canceled"
+		if !done {
+			open++
+		}
+
+		if issue.Milestone != nil && byMilestone[issue.Milestone.ID] != nil && stateType != "canceled" {
+			m := byMilestone[issue.Milestone.ID]
+			m.Total++
+			if done {
+				m.Done++
+			}
+		}
+
+		if stateType == "completed" && issue.CompletedAt != "" {
+			if completedAt, err := time.Parse(time.RFC3339, issue.CompletedAt); err == nil {
+				switch {
+				case completedAt.After(thisWeek):
+					report.ThisWeek.Issues++
+					report.ThisWeek.Points += issue.Estimate
+					report.Completed = append(report.Completed, issue)
+				case completedAt.After(lastWeek):
+					report.LastWeek.Issues++
+					report.LastWeek.Points += issue.Estimate
+				}
+			}
+		}
+
+		if !done && issue.DueDate != "" && issue.DueDate < today {
+			report.Overdue = append(report.Overdue, issue)
+		}
+		if !done && len(issue.BlockedBy) > 0 {
+			report.Blocked = append(report.Blocked, issue)
+		}
+	}
+
+	for i := range report.Milestones {
+		m := &report.Milestones[i]
+		if m.Total > 0 {
+			m.Progress = float64(m.Done) / float64(m.Total)
+		}
+		m.Overdue = m.TargetDate != "" && m.TargetDate < today && m.Done < m.Total
+	}
+
+	sort.SliceStable(report.Overdue, func(i, j int) bool {
+		return report.Overdue[i].DueDate < report.Overdue[j].DueDate
+	})
+
+	report.Health, report.HealthReasons = suggestProjectHealth(report, open, today)
+	report.Body = renderProjectReport(report)
+
+	return report
+}
+
+// suggestProjectHealth applies the heuristic described in `project report
+// --help` and explains its choice
+func suggestProjectHealth(r *ProjectReport, open int, today string) (string, []string) {
+	offTrack := []string{}
+	if r.Project.TargetDate != "" && r.Project.TargetDate < today && open > 0 {
+		offTrack = append(offTrack, fmt.Sprintf("Project target date %s has passed with %d open issues", r.Project.TargetDate, open))
+	}
+	for _, m := range r.Milestones {
+		if m.Overdue {
+			offTrack = append(offTrack, fmt.Sprintf("Milestone %s was due %s and is %d/%d done", m.Name, m.TargetDate, m.Done, m.Total))
+		}
+	}
+	if len(offTrack) > 0 {
+		return "offTrack", offTrack
+	}
+
+	atRisk := []string{}
+	if len(r.Overdue) > 0 {
+		atRisk = append(atRisk, fmt.Sprintf("%d overdue issues", len(r.Overdue)))
+	}
+	if len(r.Blocked) > 0 {
+		atRisk = append(atRisk, fmt.Sprintf("%d blocked issues", len(r.Blocked)))
+	}
+	if r.ThisWeek.Issues == 0 && r.ByStateType["started"] > 0 {
+		atRisk = append(atRisk, "No issues completed in the last 7 days")
+	}
+	if len(atRisk) > 0 {
+		return "atRisk", atRisk
+	}
+
+	return "onTrack", []string{"No overdue, blocked, or stalled work"}
+}
+
+// renderProjectReport drafts the Markdown status update body
+func renderProjectReport(r *ProjectReport) string {
+	var b strings.Builder
+
+	done := r.ByStateType["completed"]
+	scope := r.Total - r.ByStateType["canceled"]
+	percent := 0.0
+	if scope > 0 {
+		percent = float64(done) / float64(scope) * 100
+	}
+
+	b.WriteString("## Summary\n\n")
+	fmt.Fprintf(&b, "- %d of %d issues done (%.0f%%)", done, scope, percent)
+	fmt.Fprintf(&b, ", %d in progress, %d not started\n", r.ByStateType["started"], r.ByStateType["unstarted"]+r.ByStateType["backlog"]+r.ByStateType["triage"])
+	fmt.Fprintf(&b, "- Completed in the last 7 days: %s (previous 7 days: %s)\n", formatReportWindow(r.ThisWeek), formatReportWindow(r.LastWeek))
+	if r.Project.TargetDate != "" {
+		fmt.Fprintf(&b, "- Target date: %s\n", r.Project.TargetDate)
+	}
+
+	if len(r.Milestones) > 0 {
+		b.WriteString("\n## Milestones\n\n")
+		for _, m := range r.Milestones {
+			fmt.Fprintf(&b, "- %s: %d/%d done (%.0f%%)", m.Name, m.Done, m.Total, m.Progress*100)
+			if m.TargetDate != "" {
+				fmt.Fprintf(&b, ", target %s", m.TargetDate)
+			}
+			if m.Overdue {
+				b.WriteString(" (overdue)")
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	if len(r.Overdue) > 0 {
+		b.WriteString("\n## Overdue\n\n")
+		for _, issue := range r.Overdue {
+			fmt.Fprintf(&b, "- %s %s (due %s%s)\n", issue.Identifier, issue.Title, issue.DueDate, formatReportAssignee(issue))
+		}
+	}
+
+	if len(r.Blocked) > 0 {
+		b.WriteString("\n## Blocked\n\n")
+		for _, issue := range r.Blocked {
+			blockers := make([]string, len(issue.BlockedBy))
+			for i, blocker := range issue.BlockedBy {
+				blockers[i] = blocker.Identifier
+			}
+			fmt.Fprintf(&b, "- %s %s, blocked by %s\n", issue.Identifier, issue.Title, strings.Join(blockers, ", "))
+		}
+	}
+
+	if len(r.Completed) > 0 {
+		b.WriteString("\n## Completed in the last 7 days\n\n")
+		for _, issue := range r.Completed {
+			fmt.Fprintf(&b, "- %s %s\n", issue.Identifier, issue.Title)
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func formatReportWindow(w ProjectReportWindow) string {
+	if w.Points > 0 {
+		return fmt.Sprintf("%d issues, %g points", w.Issues, w.Points)
+	}
+	return fmt.Sprintf("%d issues", w.Issues)
+}
+
+func formatReportAssignee(issue api.ProjectIssue) string {
+	if issue.Assignee == nil {
+		return ""
+	}
+	return ", " + issue.Assignee.DisplayName
+}
@@ -0,0 +1,620 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// Roadmap is a timeline of projects grouped by initiative
+type Roadmap struct {
+	Today  string         `json:"today"`
+	Start  string         `json:"start"`
+	End    string         `json:"end"`
+	Groups []RoadmapGroup `json:"groups"`
+	Count  int            `json:"count"`
+}
+
+// RoadmapGroup is the projects in one initiative. Initiative is nil for
+// projects that aren't in any initiative.
+type RoadmapGroup struct {
+	Initiative *api.InitiativeRef `json:"initiative"`
+	Projects   []RoadmapItem      `json:"projects"`
+}
+
+// RoadmapItem is a project on the roadmap with its schedule flags
+type RoadmapItem struct {
+	Project             api.RoadmapProject `json:"project"`
+	Undated             bool               `json:"undated"`
+	Overdue             bool               `json:"overdue"`
+	OverdueMilestoneIDs []string           `json:"overdueMilestoneIds"`
+}
+
+const (
+	roadmapDateLayout = "2006-01-02"
+	roadmapLabelWidth = 30
+)
+
+// NewRoadmapCmd creates the roadmap command
+func NewRoadmapCmd() *cobra.Command {
+	var (
+		initiativeID     string
+		teamKey          string
+		format           string
+		outputPath       string
+		width            int
+		includeCompleted bool
+		limit            int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "roadmap",
+		Short: "Show a project timeline",
+		Long: `Show projects on a timeline, grouped by initiative, with their milestones.
+
+Projects are drawn from their start date to their target date. A project
+with only a target date is drawn as a single mark, and one with only a
+start date runs to today. Projects without dates are listed but not drawn.
+Today is marked, and milestones past their target date on unfinished
+projects are flagged as overdue.
+
+Output:
+  (default)        JSON roadmap data
+  --human          Gantt chart in the terminal
+  --format svg     SVG image
+  --format mermaid Mermaid gantt diagram
+
+Completed and canceled projects are hidden unless --include-completed is set.
+
+Examples:
+  linear roadmap --human
+  linear roadmap --team ENG --human --width 80
+  linear roadmap --initiative <initiative-id> --format svg -o roadmap.svg
+  linear roadmap --format mermaid > roadmap.mmd`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "" && format != "svg" && format != "mermaid" {
+				msg := fmt.Sprintf("invalid format %q (valid: svg, mermaid)", format)
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+			if outputPath != "" && format == "" {
+				msg := "--output requires --format svg or --format mermaid"
+				if IsHumanOutput() {
+					output.ErrorHuman(msg)
+					return nil
+				}
+				return output.Error("INVALID_FLAGS", msg)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			filter := api.ProjectFilter{InitiativeID: initiativeID}
+			if !includeCompleted {
+				filter.StatusTypes = []string{"backlog", "planned", "started", "paused"}
+			}
+
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				filter.TeamID = team.ID
+			}
+
+			projects, err := client.GetRoadmapProjects(ctx, filter, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			roadmap := buildRoadmap(projects, initiativeID, time.Now())
+
+			if format == "" {
+				if IsHumanOutput() {
+					printRoadmapHuman(roadmap, width)
+					return nil
+				}
+				return output.JSON(roadmap)
+			}
+
+			var rendered string
+			if format == "svg" {
+				rendered = renderRoadmapSVG(roadmap)
+			} else {
+				rendered = renderRoadmapMermaid(roadmap)
+			}
+
+			if outputPath == "" {
+				fmt.Fprint(output.Stdout, rendered)
+				return nil
+			}
+
+			if err := os.WriteFile(outputPath, []byte(rendered), 0644); err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("WRITE_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Wrote %s roadmap to %s", format, outputPath))
+				return nil
+			}
+			return output.JSON(map[string]interface{}{
+				"success": true,
+				"format":  format,
+				"path":    outputPath,
+				"count":   roadmap.Count,
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&initiativeID, "initiative", "", "Only projects in this initiative")
+	cmd.Flags().StringVarP(&teamKey, "team", "t", "", "Only projects for this team key (e.g., ENG)")
+	cmd.Flags().StringVar(&format, "format", "", "Export format (svg, mermaid)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write the export to a file instead of stdout")
+	cmd.Flags().IntVar(&width, "width", 60, "Chart width in columns (terminal output)")
+	cmd.Flags().BoolVar(&includeCompleted, "include-completed", false, "Include completed and canceled projects")
+	cmd.Flags().IntVarP(&limit, "limit", "l", 100, "Maximum projects to include")
+
+	return cmd
+}
+
+// buildRoadmap groups projects by initiative (only initiativeID when set),
+// flags undated and overdue work, and computes the date range to draw
+func buildRoadmap(projects []api.RoadmapProject, initiativeID string, now time.Time) *Roadmap {
+	today := now.Format(roadmapDateLayout)
+	roadmap := &Roadmap{Today: today, Groups: []RoadmapGroup{}, Count: len(projects)}
+
+	start, end := today, today
+	extend := func(date string) {
+		if date == "" {
+			return
+		}
+		if date < start {
+			start = date
+		}
+		if date > end {
+			end = date
+		}
+	}
+
+	groups := map[string]*RoadmapGroup{}
+	var order []string
+	addTo := func(key string, initiative *api.InitiativeRef, item RoadmapItem) {
+		group, ok := groups[key]
+		if !ok {
+			group = &RoadmapGroup{Initiative: initiative, Projects: []RoadmapItem{}}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Projects = append(group.Projects, item)
+	}
+
+	for _, p := range projects {
+		finished := p.State == "completed" || p.State == "canceled"
+		item := RoadmapItem{
+			Project:             p,
+			Undated:             p.StartDate == "" && p.TargetDate == "",
+			Overdue:             !finished && p.TargetDate != "" && p.TargetDate < today,
+			OverdueMilestoneIDs: []string{},
+		}
+
+		extend(p.StartDate)
+		extend(p.TargetDate)
+		for _, m := range p.Milestones {
+			extend(m.TargetDate)
+			// Milestones whose issues are all done are complete, not overdue
+			if !finished && m.Progress < 1 && m.TargetDate != "" && m.TargetDate < today {
+				item.OverdueMilestoneIDs = append(item.OverdueMilestoneIDs, m.ID)
+			}
+		}
+
+		grouped := false
+		for i := range p.Initiatives {
+			initiative := p.Initiatives[i]
+			if initiativeID != "" && initiative.ID != initiativeID {
+				continue
+			}
+			addTo(initiative.ID, &initiative, item)
+			grouped = true
+		}
+		if !grouped {
+			addTo("", nil, item)
+		}
+	}
+
+	for _, key := range order {
+		group := groups[key]
+		sort.SliceStable(group.Projects, func(i, j int) bool {
+			return roadmapSortKey(group.Projects[i]) < roadmapSortKey(group.Projects[j])
+		})
+		roadmap.Groups = append(roadmap.Groups, *group)
+	}
+
+	// Initiatives by target date then name; ungrouped projects last
+	sort.SliceStable(roadmap.Groups, func(i, j int) bool {
+		a, b := roadmap.Groups[i].Initiative, roadmap.Groups[j].Initiative
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		if (a.TargetDate == "") != (b.TargetDate == "") {
+			return a.TargetDate != ""
+		}
+		if a.TargetDate != b.TargetDate {
+			return a.TargetDate < b.TargetDate
+		}
+		return a.Name < b.Name
+	})
+
+	roadmap.Start, roadmap.End = start, end
+	return roadmap
+}
+
+// roadmapSortKey orders projects by their first date, undated last
+func roadmapSortKey(item RoadmapItem) string {
+	date := item.Project.StartDate
+	if date == "" {
+		date = item.Project.TargetDate
+	}
+	if date == "" {
+		date = "9999-99-99"
+	}
+	return date + item.Project.Name
+}
+
+func parseRoadmapDate(date string) time.Time {
+	t, _ := time.Parse(roadmapDateLayout, date)
+	return t
+}
+
+func printRoadmapHuman(r *Roadmap, width int) {
+	if r.Count == 0 {
+		output.HumanLn("No projects found")
+		return
+	}
+
+	tl := display.NewTimeline(parseRoadmapDate(r.Start), parseRoadmapDate(r.End), width)
+	today := parseRoadmapDate(r.Today)
+	todayCol := tl.Column(today)
+	indent := strings.Repeat(" ", roadmapLabelWidth)
+
+	output.HumanLn("%s%s", indent, output.Muted("%s", tl.Axis()))
+	output.HumanLn("%s%s%s", indent, strings.Repeat(" ", todayCol), output.Red("▼ today"))
+
+	for _, group := range r.Groups {
+		output.HumanLn("")
+		if group.Initiative != nil {
+			output.HumanLn("%s", output.Bold("%s", group.Initiative.Name))
+		} else {
+			output.HumanLn("%s", output.Bold("No initiative"))
+		}
+
+		for _, item := range group.Projects {
+			label := display.Pad("  "+display.Truncate(item.Project.Name, roadmapLabelWidth-4), roadmapLabelWidth)
+			if item.Undated {
+				output.HumanLn("%s%s", label, output.Muted("(no dates)"))
+				continue
+			}
+
+			suffix := ""
+			if item.Overdue {
+				suffix = " " + output.Red("overdue")
+			}
+			output.HumanLn("%s%s %s%s", label, roadmapRow(tl, item, todayCol, today), formatProjectHealth(item.Project.Health), suffix)
+		}
+	}
+
+	output.HumanLn("")
+	output.HumanLn("%s", output.Muted("█ project  ░ started, no target  ■ target only  ◆ milestone  %s overdue milestone  │ today", output.Red("◆")))
+}
+
+// roadmapCell is one column of a chart row and the color it's drawn in
+type roadmapCell struct {
+	ch    rune
+	color string // muted, bold, green, yellow, red, cyan, or "" for none
+}
+
+var roadmapColors = map[string]func(format string, args ...interface{}) string{
+	"muted":  output.Muted,
+	"bold":   output.Bold,
+	"green":  output.Green,
+	"yellow": output.Yellow,
+	"red":    output.Red,
+	"cyan":   output.Cyan,
+}
+
+// roadmapRow draws one project's bar, milestones and the today marker
+func roadmapRow(tl display.Timeline, item RoadmapItem, todayCol int, today time.Time) string {
+	cells := make([]roadmapCell, tl.Width)
+	for i := range cells {
+		cells[i] = roadmapCell{ch: ' '}
+	}
+	cells[todayCol] = roadmapCell{'│', "muted"}
+
+	barColor := roadmapHealthColor(item.Project.Health)
+	p := item.Project
+	switch {
+	case p.StartDate != "" && p.TargetDate != "":
+		for i := tl.Column(parseRoadmapDate(p.StartDate)); i <= tl.Column(parseRoadmapDate(p.TargetDate)); i++ {
+			cells[i] = roadmapCell{'█', barColor}
+		}
+	case p.StartDate != "":
+		from := tl.Column(parseRoadmapDate(p.StartDate))
+		to := tl.Column(today)
+		if to < from {
+			to = from
+		}
+		for i := from; i <= to; i++ {
+			cells[i] = roadmapCell{'░', barColor}
+		}
+	default:
+		cells[tl.Column(parseRoadmapDate(p.TargetDate))] = roadmapCell{'■', barColor}
+	}
+
+	for _, m := range p.Milestones {
+		if m.TargetDate == "" {
+			continue
+		}
+		col := tl.Column(parseRoadmapDate(m.TargetDate))
+		if containsString(item.OverdueMilestoneIDs, m.ID) {
+			cells[col] = roadmapCell{'◆', "red"}
+		} else {
+			cells[col] = roadmapCell{'◆', "bold"}
+		}
+	}
+
+	// Color runs of same-colored cells together
+	var b strings.Builder
+	for i := 0; i < len(cells); {
+		j := i
+		var run []rune
+		for j < len(cells) && cells[j].color == cells[i].color {
+			run = append(run, cells[j].ch)
+			j++
+		}
+		if paint, ok := roadmapColors[cells[i].color]; ok {
+			b.WriteString(paint("%s", string(run)))
+		} else {
+			b.WriteString(string(run))
+		}
+		i = j
+	}
+
+	return b.String()
+}
+
+func roadmapHealthColor(health string) string {
+	switch health {
+	case "onTrack":
+		return "green"
+	case "atRisk":
+		return "yellow"
+	case "offTrack":
+		return "red"
+	default:
+		return "cyan"
+	}
+}
+
+// SVG layout, in pixels
+const (
+	svgLabelWidth = 240
+	svgChartWidth = 800
+	svgRowHeight  = 26
+	svgHeader     = 40
+)
+
+var svgHealthFill = map[string]string{
+	"onTrack":  "#4cb782",
+	"atRisk":   "#f2c94c",
+	"offTrack": "#eb5757",
+}
+
+// renderRoadmapSVG draws the roadmap as a standalone SVG image
+func renderRoadmapSVG(r *Roadmap) string {
+	tl := display.NewTimeline(parseRoadmapDate(r.Start), parseRoadmapDate(r.End), svgChartWidth)
+	x := func(date string) float64 {
+		return svgLabelWidth + tl.Fraction(parseRoadmapDate(date))*svgChartWidth
+	}
+
+	rows := 0
+	for _, g := range r.Groups {
+		rows += 1 + len(g.Projects)
+	}
+	height := svgHeader + rows*svgRowHeight + 10
+	totalWidth := svgLabelWidth + svgChartWidth + 20
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n", totalWidth, height, totalWidth, height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="#ffffff"/>`+"\n")
+
+	for _, m := range tl.Months() {
+		mx := x(m.Format(roadmapDateLayout))
+		fmt.Fprintf(&b, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#e6e6e6"/>`+"\n", mx, svgHeader-10, mx, height)
+		fmt.Fprintf(&b, `<text x="%.1f" y="%d" fill="#666666">%s</text>`+"\n", mx+3, svgHeader-16, m.Format("Jan 2006"))
+	}
+
+	y := svgHeader
+	for _, g := range r.Groups {
+		name := "No initiative"
+		if g.Initiative != nil {
+			name = g.Initiative.Name
+		}
+		fmt.Fprintf(&b, `<text x="8" y="%d" font-weight="bold">%s</text>`+"\n", y+17, html.EscapeString(name))
+		y += svgRowHeight
+
+		for _, item := range g.Projects {
+			p := item.Project
+			fmt.Fprintf(&b, `<text x="20" y="%d">%s</text>`+"\n", y+17, html.EscapeString(display.Truncate(p.Name, 32)))
+
+			fill := svgHealthFill[p.Health]
+			if fill == "" {
+				fill = "#5e6ad2"
+			}
+
+			switch {
+			case item.Undated:
+				fmt.Fprintf(&b, `<text x="%d" y="%d" fill="#999999" font-style="italic">no dates</text>`+"\n", svgLabelWidth+4, y+17)
+			case p.StartDate != "" && p.TargetDate != "":
+				x1, x2 := x(p.StartDate), x(p.TargetDate)
+				fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="%.1f" height="14" rx="3" fill="%s"/>`+"\n", x1, y+6, maxFloat(x2-x1, 4), fill)
+			case p.StartDate != "":
+				x1, x2 := x(p.StartDate), x(r.Today)
+				fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="%.1f" height="14" rx="3" fill="%s" fill-opacity="0.4"/>`+"\n", x1, y+6, maxFloat(x2-x1, 4), fill)
+			default:
+				fmt.Fprintf(&b, `<rect x="%.1f" y="%d" width="8" height="14" fill="%s"/>`+"\n", x(p.TargetDate)-4, y+6, fill)
+			}
+
+			for _, m := range p.Milestones {
+				if m.TargetDate == "" {
+					continue
+				}
+				mx, my := x(m.TargetDate), float64(y+13)
+				color := "#333333"
+				if containsString(item.OverdueMilestoneIDs, m.ID) {
+					color = "#eb5757"
+				}
+				fmt.Fprintf(&b, `<polygon points="%.1f,%.1f %.1f,%.1f %.1f,%.1f %.1f,%.1f" fill="%s"><title>%s %s</title></polygon>`+"\n",
+					mx, my-6, mx+6, my, mx, my+6, mx-6, my, color, html.EscapeString(m.Name), m.TargetDate)
+			}
+
+			if item.Overdue {
+				fmt.Fprintf(&b, `<text x="%d" y="%d" fill="#eb5757">overdue</text>`+"\n", svgLabelWidth+svgChartWidth-40, y+17)
+			}
+
+			y += svgRowHeight
+		}
+	}
+
+	tx := x(r.Today)
+	fmt.Fprintf(&b, `<line x1="%.1f" y1="%d" x2="%.1f" y2="%d" stroke="#eb5757" stroke-dasharray="4 3"/>`+"\n", tx, svgHeader-10, tx, height)
+	fmt.Fprintf(&b, `<text x="%.1f" y="%d" fill="#eb5757">today</text>`+"\n", tx+3, svgHeader-2)
+	b.WriteString("</svg>\n")
+
+	return b.String()
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// renderRoadmapMermaid renders the roadmap as a Mermaid gantt diagram.
+// Undated projects can't be placed, so they are listed in comments.
+func renderRoadmapMermaid(r *Roadmap) string {
+	var b strings.Builder
+	b.WriteString("gantt\n")
+	b.WriteString("    title Roadmap\n")
+	b.WriteString("    dateFormat YYYY-MM-DD\n")
+
+	var undated []string
+	taskID := 0
+	for _, g := range r.Groups {
+		name := "No initiative"
+		if g.Initiative != nil {
+			name = g.Initiative.Name
+		}
+		fmt.Fprintf(&b, "    section %s\n", mermaidText(name))
+
+		for _, item := range g.Projects {
+			p := item.Project
+			if item.Undated {
+				undated = append(undated, p.Name)
+				continue
+			}
+
+			tags := []string{}
+			switch {
+			case p.State == "completed":
+				tags = append(tags, "done")
+			case p.State == "started":
+				tags = append(tags, "active")
+			}
+			if item.Overdue || p.Health == "offTrack" {
+				tags = append(tags, "crit")
+			}
+
+			taskID++
+			switch {
+			case p.StartDate != "" && p.TargetDate != "":
+				tags = append(tags, fmt.Sprintf("p%d", taskID), p.StartDate, p.TargetDate)
+			case p.StartDate != "":
+				endDate := r.Today
+				if endDate <= p.StartDate {
+					endDate = p.StartDate
+				}
+				tags = append(tags, fmt.Sprintf("p%d", taskID), p.StartDate, endDate)
+			default:
+				tags = append([]string{"milestone"}, tags...)
+				tags = append(tags, fmt.Sprintf("p%d", taskID), p.TargetDate, "0d")
+			}
+			fmt.Fprintf(&b, "    %s :%s\n", mermaidText(p.Name), strings.Join(tags, ", "))
+
+			for _, m := range p.Milestones {
+				if m.TargetDate == "" {
+					continue
+				}
+				mtags := []string{"milestone"}
+				if containsString(item.OverdueMilestoneIDs, m.ID) {
+					mtags = append(mtags, "crit")
+				}
+				taskID++
+				mtags = append(mtags, fmt.Sprintf("m%d", taskID), m.TargetDate, "0d")
+				fmt.Fprintf(&b, "    %s :%s\n", mermaidText(p.Name+" / "+m.Name), strings.Join(mtags, ", "))
+			}
+		}
+	}
+
+	for _, name := range undated {
+		fmt.Fprintf(&b, "    %%%% No dates: %s\n", mermaidText(name))
+	}
+
+	return b.String()
+}
+
+// mermaidText strips characters that end a gantt task name or start a
+// comment
+func mermaidText(s string) string {
+	return strings.NewReplacer(":", "", "#", "", ";", ",", "\n", " ").Replace(s)
+}
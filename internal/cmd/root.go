@@ -1,10 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
 	"github.com/spf13/cobra"
 )
 
@@ -13,6 +20,14 @@ var (
 	humanOutput bool
 	teamID      string
 	projectID   string
+	asAppUser   string
+	asAppIcon   string
+	debug       bool
+	traceFile   string
+	timeout     time.Duration
+
+	// cancelTimeout releases the --timeout deadline once the command ends
+	cancelTimeout context.CancelFunc = func() {}
 )
 
 // NewRootCmd creates the root command for the Linear CLI
@@ -35,16 +50,55 @@ Quick Start:
   linear project list    List all projects
   linear document list   List documents`,
 		Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date),
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if GetAppActor() != nil && cmd.Annotations[appActorAnnotation] == "" {
+				return rejectAppActor(cmd)
+			}
+
 			// Load configuration before each command
 			// This will be implemented in config package
+
+			api.ConfigureTracing(api.TraceOptions{
+				Debug:   debug,
+				File:    traceFile,
+				Version: version,
+			})
+
+			if timeout > 0 {
+				ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+				cancelTimeout = cancel
+				cmd.SetContext(ctx)
+			}
+			return nil
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			cancelTimeout()
 		},
 	}
 
+	// Ctrl-C and SIGTERM cancel the command's context so in-flight requests
+	// stop and batch commands can report what completed. A second signal
+	// terminates immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	rootCmd.SetContext(ctx)
+
 	// Global flags
 	rootCmd.PersistentFlags().BoolVar(&humanOutput, "human", false, "Output in human-readable format (default: JSON)")
 	rootCmd.PersistentFlags().StringVar(&teamID, "team", "", "Team ID or key (overrides config)")
 	rootCmd.PersistentFlags().StringVar(&projectID, "project", "", "Project ID (overrides VCS detection)")
+	rootCmd.PersistentFlags().StringVar(&asAppUser, "as-app-user", "", "Display name for issues, comments and project updates created as the app (client credentials only)")
+	rootCmd.PersistentFlags().StringVar(&asAppIcon, "as-app-icon", "", "Avatar URL for content created as the app (client credentials only)")
+
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Log each API request to stderr (also LINEAR_DEBUG=1)")
+	rootCmd.PersistentFlags().StringVar(&traceFile, "trace-file", "", "Write API requests and responses to a HAR file")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Abort the command after this long (e.g. 30s, 2m; default: no limit)")
+
+	// Cobra's own error and usage messages go through redaction too
+	rootCmd.SetErr(output.Stderr)
 
 	// Add command groups
 	rootCmd.AddCommand(NewAuthCmd())
@@ -57,6 +111,10 @@ Quick Start:
 	rootCmd.AddCommand(NewUserCmd())
 	rootCmd.AddCommand(NewTeamCmd())
 	rootCmd.AddCommand(NewInitiativeCmd())
+	rootCmd.AddCommand(NewRoadmapCmd())
+	rootCmd.AddCommand(NewInboxCmd())
+	rootCmd.AddCommand(NewWatchCmd())
+	rootCmd.AddCommand(NewWebhookCmd())
 	rootCmd.AddCommand(NewConfigCmd())
 	rootCmd.AddCommand(NewWhoamiCmd())
 
@@ -65,14 +123,14 @@ Quick Start:
 
 // OutputJSON outputs data as JSON (default mode)
 func OutputJSON(data interface{}) error {
-	encoder := json.NewEncoder(os.Stdout)
+	encoder := json.NewEncoder(output.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(data)
 }
 
 // OutputHuman outputs data in human-readable format
 func OutputHuman(format string, args ...interface{}) {
-	fmt.Printf(format, args...)
+	fmt.Fprintf(output.Stdout, format, args...)
 }
 
 // IsHumanOutput returns whether human output mode is enabled
@@ -89,3 +147,69 @@ func GetTeamID() string {
 func GetProjectID() string {
 	return projectID
 }
+
+// GetAppActor returns the --as-app-user/--as-app-icon identity, or nil when
+// neither flag is set
+func GetAppActor() *api.AppActor {
+	if asAppUser == "" && asAppIcon == "" {
+		return nil
+	}
+	return &api.AppActor{Name: asAppUser, IconURL: asAppIcon}
+}
+
+// appActorAnnotation marks commands that create content as the app with
+// --as-app-user/--as-app-icon; the flags are rejected everywhere else
+const appActorAnnotation = "linear:app-actor"
+
+// honorAppActor marks cmd as applying --as-app-user/--as-app-icon
+func honorAppActor(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[appActorAnnotation] = "true"
+	return cmd
+}
+
+// rejectAppActor reports --as-app-user/--as-app-icon on a command that
+// would otherwise ignore them. The returned error only stops the command;
+// it is already reported.
+func rejectAppActor(cmd *cobra.Command) error {
+	msg := fmt.Sprintf("'%s' does not support --as-app-user/--as-app-icon", cmd.CommandPath())
+	hint := "Only issue create, issue comment create, project update-status create and project report --post create content as the app; drop the flags here"
+	if IsHumanOutput() {
+		output.ErrorHumanWithHint(msg, hint)
+	} else {
+		output.ErrorWithHint("APP_ACTOR_UNSUPPORTED", msg, hint)
+	}
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	return errors.New(msg)
+}
+
+// applyAppActor configures the client to create content as the app when
+// --as-app-user or --as-app-icon is set. Errors are already reported; the
+// caller should return the result as-is.
+func applyAppActor(client *api.Client) (bool, error) {
+	actor := GetAppActor()
+	if actor == nil {
+		return true, nil
+	}
+
+	if err := client.SetAppActor(*actor); err != nil {
+		hint := "Run 'linear auth login --client-credentials', or drop --as-app-user/--as-app-icon"
+		if IsHumanOutput() {
+			output.ErrorHumanWithHint(err.Error(), hint)
+			return false, nil
+		}
+		return false, output.ErrorWithHint("APP_ACTOR_UNSUPPORTED", err.Error(), hint)
+	}
+	return true, nil
+}
+
+// interruptReason describes why a command's context ended
+func interruptReason(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Sprintf("timed out after %s (--timeout)", timeout)
+	}
+	return "interrupted"
+}
@@ -62,7 +62,7 @@ Examples:
   linear status list --refresh
   linear status list --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -134,7 +134,7 @@ This fetches fresh data from Linear and updates the local cache.
 Examples:
   linear status cache`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
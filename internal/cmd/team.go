@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"sort"
 
 	"github.com/juanbermudez/agent-linear-cli/internal/api"
@@ -40,7 +39,7 @@ Examples:
   linear team list
   linear team list --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// addUnsetFlag registers --unset for the clearable fields of an update
+// command. Field names match the flags that set them.
+func addUnsetFlag(cmd *cobra.Command, target *[]string, fields []string) {
+	cmd.Flags().StringSliceVar(target, "unset", nil, fmt.Sprintf("Clear fields (%s)", strings.Join(fields, ", ")))
+}
+
+// emptyFieldError reports a clearable field set to an empty value, which
+// would otherwise count as an update and then fail at the API
+type emptyFieldError struct {
+	field string
+}
+
+func (e *emptyFieldError) Error() string {
+	return fmt.Sprintf("--%s cannot be empty", e.field)
+}
+
+// resolveUnset validates --unset values against a command's clearable
+// fields and rejects fields that are also being set, or set to ""
+func resolveUnset(cmd *cobra.Command, values, fields []string) (map[string]bool, error) {
+	for _, field := range fields {
+		flag := cmd.Flags().Lookup(field)
+		if flag != nil && flag.Changed && strings.TrimSpace(flag.Value.String()) == "" {
+			return nil, &emptyFieldError{field: field}
+		}
+	}
+
+	cleared := map[string]bool{}
+	for _, v := range values {
+		field := strings.ToLower(strings.TrimSpace(v))
+		if !containsString(fields, field) {
+			return nil, fmt.Errorf("cannot unset %q (clearable fields: %s)", v, strings.Join(fields, ", "))
+		}
+		if cmd.Flags().Changed(field) {
+			return nil, fmt.Errorf("--%s and --unset %s cannot be used together", field, field)
+		}
+		cleared[field] = true
+	}
+	return cleared, nil
+}
+
+// reportUnsetError outputs an invalid --unset error
+func reportUnsetError(err error, usage string) error {
+	code := "INVALID_UNSET"
+	hint := "Pass a clearable field name to --unset, and don't set the same field"
+
+	var empty *emptyFieldError
+	if errors.As(err, &empty) {
+		code = "INVALID_FLAGS"
+		hint = fmt.Sprintf("Use --unset %s to clear the field", empty.field)
+	}
+
+	if IsHumanOutput() {
+		output.ErrorHumanWithHint(err.Error(), hint, usage)
+		return nil
+	}
+	return output.ErrorWithHint(code, err.Error(), hint, usage)
+}
+
+// nullableField returns null for cleared fields and the flag value otherwise
+func nullableField(cleared map[string]bool, field, value string) api.Nullable {
+	if cleared[field] {
+		return api.Null()
+	}
+	return api.Value(value)
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -64,7 +65,7 @@ Examples:
   linear user list --admins-only
   linear user list --refresh`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -155,7 +156,7 @@ Examples:
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			query := args[0]
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -329,3 +330,112 @@ func printUserSearchHuman(response *UserSearchResponse) {
 	output.TableWithColors(headers, rows)
 	output.HumanLn("\n%d users found", response.Count)
 }
+
+// resolveUserRef returns the viewer's ID for "self" or "me", and the
+// reference unchanged otherwise
+func resolveUserRef(ctx context.Context, client *api.Client, ref string) (string, error) {
+	if ref != "self" && ref != "me" {
+		return ref, nil
+	}
+	viewerID, err := client.GetViewerID(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current user: %w", err)
+	}
+	return viewerID, nil
+}
+
+// findUser matches a user by ID, email, or display or full name
+// (case-insensitive)
+func findUser(users []api.User, ref string) (*api.User, error) {
+	var matches []api.User
+	for _, u := range users {
+		if u.ID == ref || strings.EqualFold(u.Email, ref) {
+			return &u, nil
+		}
+		if strings.EqualFold(u.DisplayName, ref) || strings.EqualFold(u.Name, ref) {
+			matches = append(matches, u)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("user not found: %s", ref)
+	case 1:
+		return &matches[0], nil
+	}
+
+	emails := make([]string, len(matches))
+	for i, u := range matches {
+		emails[i] = u.Email
+	}
+	return nil, fmt.Errorf("user %q is ambiguous: %s (use the email or user ID)", ref, strings.Join(emails, ", "))
+}
+
+// resolveUsers resolves user references ("self", an ID, email, or display
+// name) to users. The cached user list is tried first and refreshed once
+// if a reference isn't found in it. On failure it reports the error and
+// returns ok=false.
+func resolveUsers(ctx context.Context, client *api.Client, refs []string) ([]api.User, bool, error) {
+	reportAPIError := func(err error) ([]api.User, bool, error) {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil, false, nil
+		}
+		return nil, false, output.Error("API_ERROR", err.Error())
+	}
+
+	cacheManager, _ := cache.NewManager()
+	cacheKey := cache.WorkspaceKey("users")
+
+	var users *api.UsersResponse
+	fromCache := false
+	if cacheManager != nil {
+		users, _ = cache.Read[api.UsersResponse](cacheManager, cacheKey)
+		fromCache = users != nil
+	}
+
+	fetch := func() error {
+		fetched, err := client.GetUsers(ctx)
+		if err != nil {
+			return err
+		}
+		if cacheManager != nil {
+			cache.Write(cacheManager, cacheKey, *fetched)
+		}
+		users = fetched
+		fromCache = false
+		return nil
+	}
+	if users == nil {
+		if err := fetch(); err != nil {
+			return reportAPIError(err)
+		}
+	}
+
+	resolved := make([]api.User, 0, len(refs))
+	for _, ref := range refs {
+		id, err := resolveUserRef(ctx, client, ref)
+		if err != nil {
+			return reportAPIError(err)
+		}
+
+		user, err := findUser(users.Users, id)
+		if err != nil && fromCache {
+			if fetchErr := fetch(); fetchErr != nil {
+				return reportAPIError(fetchErr)
+			}
+			user, err = findUser(users.Users, id)
+		}
+		if err != nil {
+			hint := "Search for users with 'linear user search <name>'"
+			if IsHumanOutput() {
+				output.ErrorHumanWithHint(err.Error(), hint)
+				return nil, false, nil
+			}
+			return nil, false, output.ErrorWithHint("USER_NOT_FOUND", err.Error(), hint)
+		}
+		resolved = append(resolved, *user)
+	}
+
+	return resolved, true, nil
+}
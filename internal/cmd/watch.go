@@ -0,0 +1,496 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// WatchEvent is a single NDJSON change event emitted by watch commands
+type WatchEvent struct {
+	Event         string      `json:"event"` // created, updated, deleted, error
+	Entity        string      `json:"entity"`
+	ID            string      `json:"id,omitempty"`
+	ChangedFields []string    `json:"changedFields,omitempty"`
+	UpdatedAt     string      `json:"updatedAt,omitempty"`
+	Data          interface{} `json:"data,omitempty"`
+	Error         string      `json:"error,omitempty"`
+}
+
+// watchRecord is an entity snapshot normalized for diffing
+type watchRecord struct {
+	ID        string
+	Label     string
+	CreatedAt string
+	UpdatedAt string
+	Deleted   bool
+	Data      interface{}
+}
+
+// watchFetchFunc fetches records updated after since (empty for a baseline)
+type watchFetchFunc func(ctx context.Context, since string, limit int) ([]watchRecord, error)
+
+// watchOptions holds flags shared by all watch subcommands
+type watchOptions struct {
+	interval time.Duration
+	baseline int
+}
+
+// NewWatchCmd creates the watch command group
+func NewWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream changes as NDJSON events",
+		Long: `Poll Linear for changes and stream them as newline-delimited JSON.
+
+Each line is an event:
+  {"event": "created|updated|deleted", "entity": "issue", "id": "...",
+   "changedFields": ["state", "assignee"], "updatedAt": "...", "data": {...}}
+
+Polling uses updatedAt watermarks, so each poll only fetches entities that
+changed since the last one. Archived or trashed entities are reported as
+"deleted". Runs until interrupted (Ctrl+C).
+
+Examples:
+  linear watch issues --team ENG
+  linear watch issues --team ENG --assignee me --interval 1m
+  linear watch projects
+  linear watch comments ENG-123`,
+	}
+
+	cmd.AddCommand(newWatchIssuesCmd())
+	cmd.AddCommand(newWatchProjectsCmd())
+	cmd.AddCommand(newWatchCommentsCmd())
+
+	return cmd
+}
+
+func newWatchIssuesCmd() *cobra.Command {
+	var (
+		opts       watchOptions
+		teamKey    string
+		assignee   string
+		unassigned bool
+		stateTypes []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "issues",
+		Short: "Watch issues for changes",
+		Long: `Stream issue changes as NDJSON events.
+
+State types: triage, backlog, unstarted, started, completed, canceled
+
+Examples:
+  linear watch issues --team ENG
+  linear watch issues --team ENG --assignee me
+  linear watch issues --team ENG --state started --interval 10s`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			filter := api.IssueFilter{
+				ProjectID:  GetProjectID(),
+				StateTypes: stateTypes,
+				Unassigned: unassigned,
+			}
+
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				filter.TeamID = team.ID
+			}
+
+			if !unassigned && assignee != "" {
+				if assignee == "self" || assignee == "me" {
+					viewerID, err := client.GetViewerID(ctx)
+					if err != nil {
+						if IsHumanOutput() {
+							output.ErrorHuman("Failed to get current user: " + err.Error())
+							return nil
+						}
+						return output.Error("API_ERROR", "Failed to get current user: "+err.Error())
+					}
+					filter.AssigneeID = viewerID
+				} else {
+					filter.AssigneeID = assignee
+				}
+			}
+
+			fetch := func(ctx context.Context, since string, limit int) ([]watchRecord, error) {
+				issues, err := client.GetIssueChanges(ctx, filter, since, limit)
+				if err != nil {
+					return nil, err
+				}
+				records := make([]watchRecord, len(issues))
+				for i, issue := range issues {
+					records[i] = watchRecord{
+						ID:        issue.ID,
+						Label:     issue.Identifier + " " + issue.Title,
+						CreatedAt: issue.CreatedAt,
+						UpdatedAt: issue.UpdatedAt,
+						Deleted:   issue.ArchivedAt != "" || issue.Trashed,
+						Data:      issue,
+					}
+				}
+				return records, nil
+			}
+
+			return runWatch(cmd.Context(), "issue", fetch, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&teamKey, "team", "", "Team key (e.g., ENG)")
+	cmd.Flags().StringVarP(&assignee, "assignee", "a", "", "Filter by assignee (use 'me' for yourself)")
+	cmd.Flags().BoolVar(&unassigned, "unassigned", false, "Only unassigned issues")
+	cmd.Flags().StringSliceVarP(&stateTypes, "state", "s", nil, "Filter by state type")
+	addWatchFlags(cmd, &opts)
+
+	return cmd
+}
+
+func newWatchProjectsCmd() *cobra.Command {
+	var (
+		opts    watchOptions
+		teamKey string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "projects",
+		Short: "Watch projects for changes",
+		Long: `Stream project changes as NDJSON events.
+
+Examples:
+  linear watch projects
+  linear watch projects --team ENG --interval 5m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			teamUUID := ""
+			if teamKey != "" {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				teamUUID = team.ID
+			}
+
+			fetch := func(ctx context.Context, since string, limit int) ([]watchRecord, error) {
+				projects, err := client.GetProjectChanges(ctx, teamUUID, since, limit)
+				if err != nil {
+					return nil, err
+				}
+				records := make([]watchRecord, len(projects))
+				for i, p := range projects {
+					records[i] = watchRecord{
+						ID:        p.ID,
+						Label:     p.Name,
+						CreatedAt: p.CreatedAt,
+						UpdatedAt: p.UpdatedAt,
+						Deleted:   p.ArchivedAt != "",
+						Data:      p,
+					}
+				}
+				return records, nil
+			}
+
+			return runWatch(cmd.Context(), "project", fetch, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&teamKey, "team", "", "Team key (e.g., ENG)")
+	addWatchFlags(cmd, &opts)
+
+	return cmd
+}
+
+func newWatchCommentsCmd() *cobra.Command {
+	var opts watchOptions
+
+	cmd := &cobra.Command{
+		Use:   "comments [issue-id]",
+		Short: "Watch comments for changes",
+		Long: `Stream comment changes as NDJSON events, optionally for a single issue.
+
+Examples:
+  linear watch comments
+  linear watch comments ENG-123`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			// Comment filters need the issue UUID, not the identifier
+			issueUUID := ""
+			if len(args) == 1 {
+				issue, err := client.GetIssue(ctx, args[0], false)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				issueUUID = issue.ID
+			}
+
+			fetch := func(ctx context.Context, since string, limit int) ([]watchRecord, error) {
+				comments, err := client.GetCommentChanges(ctx, issueUUID, since, limit)
+				if err != nil {
+					return nil, err
+				}
+				records := make([]watchRecord, len(comments))
+				for i, c := range comments {
+					label := "comment"
+					if c.Issue != nil {
+						label = "comment on " + c.Issue.Identifier
+					}
+					records[i] = watchRecord{
+						ID:        c.ID,
+						Label:     label,
+						CreatedAt: c.CreatedAt,
+						UpdatedAt: c.UpdatedAt,
+						Deleted:   c.ArchivedAt != "",
+						Data:      c,
+					}
+				}
+				return records, nil
+			}
+
+			return runWatch(cmd.Context(), "comment", fetch, opts)
+		},
+	}
+
+	addWatchFlags(cmd, &opts)
+
+	return cmd
+}
+
+func addWatchFlags(cmd *cobra.Command, opts *watchOptions) {
+	cmd.Flags().DurationVarP(&opts.interval, "interval", "i", 30*time.Second, "Polling interval")
+	cmd.Flags().IntVar(&opts.baseline, "baseline", 250, "Number of recently updated entities to snapshot at startup")
+}
+
+// runWatch builds a baseline snapshot, then polls for changes and emits
+// events until ctx is cancelled (Ctrl-C or --timeout)
+func runWatch(ctx context.Context, entity string, fetch watchFetchFunc, opts watchOptions) error {
+	if opts.interval < time.Second {
+		opts.interval = time.Second
+	}
+
+	// Match Linear's timestamp format so watermarks compare as strings
+	startedAt := time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00")
+	encoder := json.NewEncoder(output.Stdout)
+
+	baseline, err := fetch(ctx, "", opts.baseline)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil
+		}
+		return output.Error("API_ERROR", err.Error())
+	}
+
+	snapshot := make(map[string]map[string]interface{}, len(baseline))
+	watermark := ""
+	for _, r := range baseline {
+		if !r.Deleted {
+			snapshot[r.ID] = watchFields(r.Data)
+		}
+		if r.UpdatedAt > watermark {
+			watermark = r.UpdatedAt
+		}
+	}
+	if watermark == "" {
+		watermark = startedAt
+	}
+
+	if IsHumanOutput() {
+		output.HumanLn("Watching %ss every %s (%d in snapshot). Press Ctrl+C to stop.", entity, opts.interval, len(snapshot))
+	}
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		records, err := fetch(ctx, watermark, 0)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			emitWatchEvent(encoder, WatchEvent{Event: "error", Entity: entity, Error: err.Error()}, "")
+			continue
+		}
+
+		// Emit oldest first so consumers see changes in order
+		sort.Slice(records, func(i, j int) bool {
+			return records[i].UpdatedAt < records[j].UpdatedAt
+		})
+
+		for _, r := range records {
+			if r.UpdatedAt > watermark {
+				watermark = r.UpdatedAt
+			}
+
+			previous, known := snapshot[r.ID]
+			event := WatchEvent{Entity: entity, ID: r.ID, UpdatedAt: r.UpdatedAt, Data: r.Data}
+
+			if r.Deleted {
+				delete(snapshot, r.ID)
+				event.Event = "deleted"
+				emitWatchEvent(encoder, event, r.Label)
+				continue
+			}
+
+			fields := watchFields(r.Data)
+			snapshot[r.ID] = fields
+
+			switch {
+			case known:
+				event.Event = "updated"
+				event.ChangedFields = diffWatchFields(previous, fields)
+				if len(event.ChangedFields) == 0 {
+					continue
+				}
+			case r.CreatedAt >= startedAt:
+				event.Event = "created"
+			default:
+				// Existed before the watch started but was outside the baseline;
+				// changed fields are unknown
+				event.Event = "updated"
+			}
+
+			emitWatchEvent(encoder, event, r.Label)
+		}
+	}
+}
+
+// watchFields converts a record into a field map for diffing
+func watchFields(data interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(raw, &fields)
+	return fields
+}
+
+// diffWatchFields returns the sorted names of fields that differ between
+// two snapshots, ignoring bookkeeping timestamps
+func diffWatchFields(previous, current map[string]interface{}) []string {
+	changed := []string{}
+	seen := map[string]bool{}
+
+	for key, value := range current {
+		seen[key] = true
+		if key == "updatedAt" {
+			continue
+		}
+		if !reflect.DeepEqual(previous[key], value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range previous {
+		if !seen[key] {
+			changed = append(changed, key)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+func emitWatchEvent(encoder *json.Encoder, event WatchEvent, label string) {
+	if !IsHumanOutput() {
+		encoder.Encode(event)
+		return
+	}
+
+	stamp := time.Now().Format("15:04:05")
+	switch event.Event {
+	case "error":
+		output.HumanLn("%s %s %s", output.Muted("%s", stamp), output.Red("error"), event.Error)
+	case "created":
+		output.HumanLn("%s %s %s", output.Muted("%s", stamp), output.Green("created"), label)
+	case "deleted":
+		output.HumanLn("%s %s %s", output.Muted("%s", stamp), output.Red("deleted"), label)
+	default:
+		detail := ""
+		if len(event.ChangedFields) > 0 {
+			detail = output.Muted(" (%s)", strings.Join(event.ChangedFields, ", "))
+		}
+		output.HumanLn("%s %s %s%s", output.Muted("%s", stamp), output.Yellow("updated"), label, detail)
+	}
+}
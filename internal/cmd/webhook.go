@@ -0,0 +1,845 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+	"github.com/juanbermudez/agent-linear-cli/internal/config"
+	"github.com/juanbermudez/agent-linear-cli/internal/display"
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+	"github.com/juanbermudez/agent-linear-cli/internal/webhook"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// maxWebhookBody caps the size of an accepted webhook delivery
+const maxWebhookBody = 5 << 20
+
+// NewWebhookCmd creates the webhook command group
+func NewWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook",
+		Aliases: []string{"webhooks"},
+		Short:   "Manage and receive Linear webhooks",
+		Long: `Configure workspace webhooks and receive webhook deliveries.
+
+Examples:
+  linear webhook list
+  linear webhook create --url https://example.com/hook --team ENG --resource Issue
+  linear webhook disable <webhook-id>
+  linear webhook test <webhook-id>
+  linear webhook listen --port 8080 --secret $LINEAR_WEBHOOK_SECRET`,
+	}
+
+	cmd.AddCommand(newWebhookListCmd())
+	cmd.AddCommand(newWebhookCreateCmd())
+	cmd.AddCommand(newWebhookUpdateCmd())
+	cmd.AddCommand(newWebhookDeleteCmd())
+	cmd.AddCommand(newWebhookToggleCmd(true))
+	cmd.AddCommand(newWebhookToggleCmd(false))
+	cmd.AddCommand(newWebhookTestCmd())
+	cmd.AddCommand(newWebhookListenCmd())
+
+	return cmd
+}
+
+func newWebhookListCmd() *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks",
+		Long: `List the workspace's webhooks. Signing secrets are not included.
+
+Examples:
+  linear webhook list
+  linear webhook list --human`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			webhooks, err := client.GetWebhooks(ctx, limit)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			for i := range webhooks.Webhooks {
+				webhooks.Webhooks[i].Secret = ""
+			}
+
+			if IsHumanOutput() {
+				printWebhooksHuman(webhooks)
+			} else {
+				output.JSON(webhooks)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 50, "Maximum webhooks to return")
+
+	return cmd
+}
+
+func newWebhookCreateCmd() *cobra.Command {
+	var (
+		url           string
+		label         string
+		teamKey       string
+		allTeams      bool
+		resourceTypes []string
+		secret        string
+		disabled      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a webhook",
+		Long: `Create a webhook that delivers events to a URL.
+
+Scope the webhook to one team with --team, or to every public team with
+--all-teams. Resource types select which entities trigger deliveries.
+
+Resource types: Issue, Comment, IssueLabel, Project, ProjectUpdate, Cycle,
+Reaction, Document, Initiative, InitiativeUpdate, Attachment
+
+Examples:
+  linear webhook create --url https://example.com/hook --team ENG
+  linear webhook create --url https://example.com/hook --all-teams --resource Issue --resource Project
+  linear webhook create --url https://example.com/hook --team ENG --label "CI bot" --disabled`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if url == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"Webhook URL is required",
+						"Provide the delivery URL using the --url flag",
+						"linear webhook create --url https://example.com/hook --team ENG",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_URL",
+					"Webhook URL is required",
+					"Provide the delivery URL using the --url flag",
+					"linear webhook create --url https://example.com/hook --team ENG",
+				)
+			}
+
+			if teamKey == "" {
+				teamKey = GetTeamID()
+			}
+			if teamKey == "" && !allTeams {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"Team is required",
+						"Specify a team using --team, or use --all-teams for every public team",
+						"linear webhook create --url https://example.com/hook --team ENG",
+						"linear webhook create --url https://example.com/hook --all-teams",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_TEAM",
+					"Team is required",
+					"Specify a team using --team, or use --all-teams for every public team",
+					"linear webhook create --url https://example.com/hook --team ENG",
+					"linear webhook create --url https://example.com/hook --all-teams",
+				)
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			input := api.WebhookCreateInput{
+				URL:            url,
+				Label:          label,
+				AllPublicTeams: allTeams,
+				ResourceTypes:  resourceTypes,
+				Secret:         secret,
+			}
+			if disabled {
+				enabled := false
+				input.Enabled = &enabled
+			}
+
+			if !allTeams {
+				team, err := client.GetTeamByKey(ctx, teamKey)
+				if err != nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(err.Error())
+						return nil
+					}
+					return output.Error("API_ERROR", err.Error())
+				}
+				if team == nil {
+					if IsHumanOutput() {
+						output.ErrorHuman(fmt.Sprintf("Team '%s' not found", teamKey))
+						return nil
+					}
+					return output.Error("NOT_FOUND", fmt.Sprintf("Team '%s' not found", teamKey))
+				}
+				input.TeamID = team.ID
+			}
+
+			hook, err := client.CreateWebhook(ctx, input)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman(fmt.Sprintf("Created webhook %s", hook.ID))
+				output.HumanLn("  URL: %s", hook.URL)
+				output.HumanLn("  Resources: %s", strings.Join(hook.ResourceTypes, ", "))
+				if hook.Secret != "" {
+					output.HumanLn("  Signing secret: %s", hook.Secret)
+				}
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "create",
+					"webhook":   hook,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&url, "url", "", "Delivery URL (required)")
+	cmd.Flags().StringVar(&label, "label", "", "Webhook label")
+	cmd.Flags().StringVar(&teamKey, "team", "", "Team key to scope the webhook to")
+	cmd.Flags().BoolVar(&allTeams, "all-teams", false, "Deliver events for all public teams")
+	cmd.Flags().StringSliceVarP(&resourceTypes, "resource", "r", []string{"Issue", "Comment"}, "Resource types to deliver (repeatable)")
+	cmd.Flags().StringVar(&secret, "secret", "", "Signing secret (generated by Linear if omitted)")
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Create the webhook disabled")
+
+	return cmd
+}
+
+func newWebhookUpdateCmd() *cobra.Command {
+	var (
+		url           string
+		label         string
+		resourceTypes []string
+		secret        string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update <webhook-id>",
+		Short: "Update a webhook",
+		Long: `Update a webhook's URL, label, resource types or signing secret.
+
+Examples:
+  linear webhook update abc123 --url https://example.com/new-hook
+  linear webhook update abc123 --resource Issue --resource Project`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID := args[0]
+
+			input := api.WebhookUpdateInput{
+				URL:           url,
+				Label:         label,
+				ResourceTypes: resourceTypes,
+				Secret:        secret,
+			}
+
+			if url == "" && label == "" && len(resourceTypes) == 0 && secret == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"No fields to update",
+						"Provide at least one field to update",
+						"linear webhook update abc123 --url https://example.com/hook",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_FIELDS",
+					"No fields to update",
+					"Provide at least one field to update",
+					"linear webhook update abc123 --url https://example.com/hook",
+				)
+			}
+
+			return runWebhookUpdate(cmd.Context(), webhookID, input, "update", "Webhook updated")
+		},
+	}
+
+	cmd.Flags().StringVar(&url, "url", "", "New delivery URL")
+	cmd.Flags().StringVar(&label, "label", "", "New label")
+	cmd.Flags().StringSliceVarP(&resourceTypes, "resource", "r", nil, "Replace resource types (repeatable)")
+	cmd.Flags().StringVar(&secret, "secret", "", "New signing secret")
+
+	return cmd
+}
+
+// newWebhookToggleCmd builds the enable or disable command
+func newWebhookToggleCmd(enabled bool) *cobra.Command {
+	use, short, effect, done := "disable", "Disable a webhook", "stop", "Webhook disabled"
+	if enabled {
+		use, short, effect, done = "enable", "Enable a webhook", "resume", "Webhook enabled"
+	}
+
+	return &cobra.Command{
+		Use:   use + " <webhook-id>",
+		Short: short,
+		Long: fmt.Sprintf(`%s. Deliveries %s until the webhook is toggled again.
+
+Examples:
+  linear webhook %s abc123`, short, effect, use),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWebhookUpdate(cmd.Context(), args[0], api.WebhookUpdateInput{Enabled: &enabled}, use, done)
+		},
+	}
+}
+
+func runWebhookUpdate(ctx context.Context, webhookID string, input api.WebhookUpdateInput, operation, done string) error {
+	client, err := api.NewClient(ctx)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil
+		}
+		return output.Error("AUTH_ERROR", err.Error())
+	}
+
+	hook, err := client.UpdateWebhook(ctx, webhookID, input)
+	if err != nil {
+		if IsHumanOutput() {
+			output.ErrorHuman(err.Error())
+			return nil
+		}
+		return output.Error("API_ERROR", err.Error())
+	}
+	hook.Secret = ""
+
+	if IsHumanOutput() {
+		output.SuccessHuman(done)
+	} else {
+		output.JSON(map[string]interface{}{
+			"success":   true,
+			"operation": operation,
+			"webhook":   hook,
+		})
+	}
+
+	return nil
+}
+
+func newWebhookDeleteCmd() *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <webhook-id>",
+		Short: "Delete a webhook",
+		Long: `Permanently delete a webhook.
+
+Asks for confirmation when run in a terminal. Pass --yes to skip the
+prompt; it is required when stdin is not a terminal (scripts and agents).
+
+Examples:
+  linear webhook delete abc123
+  linear webhook delete abc123 --yes`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID := args[0]
+
+			if !yes {
+				if !term.IsTerminal(int(os.Stdin.Fd())) {
+					msg := fmt.Sprintf("Deleting webhook '%s' needs confirmation", webhookID)
+					hint := "Pass --yes to delete without a prompt"
+					example := fmt.Sprintf("linear webhook delete %s --yes", webhookID)
+					if IsHumanOutput() {
+						output.ErrorHumanWithHint(msg, hint, example)
+						return nil
+					}
+					return output.ErrorWithHint("CONFIRMATION_REQUIRED", msg, hint, example)
+				}
+				if !confirmPrompt(fmt.Sprintf("Permanently delete webhook %s?", webhookID)) {
+					if IsHumanOutput() {
+						output.HumanLn("Cancelled")
+						return nil
+					}
+					return output.Error("CANCELLED", "Webhook deletion cancelled")
+				}
+			}
+
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			err = client.DeleteWebhook(ctx, webhookID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				output.SuccessHuman("Webhook deleted")
+			} else {
+				output.JSON(map[string]interface{}{
+					"success":   true,
+					"operation": "delete",
+					"webhookId": webhookID,
+				})
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
+
+// confirmPrompt asks a yes/no question on stderr and reads the answer from
+// stdin. Anything but y or yes is a no.
+func confirmPrompt(question string) bool {
+	fmt.Fprintf(output.Stderr, "%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func newWebhookTestCmd() *cobra.Command {
+	var resourceType string
+
+	cmd := &cobra.Command{
+		Use:   "test <webhook-id>",
+		Short: "Send a sample payload to a webhook",
+		Long: `Send a signed sample delivery to the webhook's URL and report the response.
+
+The payload uses the webhook's first resource type unless --type is given,
+and is signed with the webhook's secret like a real delivery.
+
+Examples:
+  linear webhook test abc123
+  linear webhook test abc123 --type Comment`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID := args[0]
+			ctx := cmd.Context()
+
+			client, err := api.NewClient(ctx)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("AUTH_ERROR", err.Error())
+			}
+
+			hook, err := client.GetWebhook(ctx, webhookID)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("API_ERROR", err.Error())
+			}
+
+			if resourceType == "" {
+				resourceType = "Issue"
+				if len(hook.ResourceTypes) > 0 {
+					resourceType = hook.ResourceTypes[0]
+				}
+			}
+
+			result, err := sendWebhookTest(ctx, hook, resourceType)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("DELIVERY_ERROR", err.Error())
+			}
+
+			if IsHumanOutput() {
+				message := fmt.Sprintf("%s responded %d in %dms", hook.URL, result["statusCode"], result["durationMs"])
+				if result["success"] == true {
+					output.SuccessHuman(message)
+				} else {
+					output.ErrorHuman(message)
+				}
+			} else {
+				output.JSON(result)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&resourceType, "type", "", "Resource type of the sample payload")
+
+	return cmd
+}
+
+// sendWebhookTest delivers a signed sample payload and describes the response
+func sendWebhookTest(ctx context.Context, hook *api.Webhook, resourceType string) (map[string]interface{}, error) {
+	now := time.Now()
+	body, err := webhook.SamplePayload(resourceType, hook.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Linear-Event", resourceType)
+	req.Header.Set("Linear-Delivery", fmt.Sprintf("test-%d", now.UnixNano()))
+	if hook.Secret != "" {
+		req.Header.Set(webhook.SignatureHeader, webhook.Sign(body, hook.Secret))
+	}
+
+	// Deliver through the configured proxy, CA bundle and client certificate
+	transport, err := config.HTTPTransport()
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Transport: transport, Timeout: 10 * time.Second}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return map[string]interface{}{
+		"success":      resp.StatusCode >= 200 && resp.StatusCode < 300,
+		"operation":    "test",
+		"webhookId":    hook.ID,
+		"url":          hook.URL,
+		"resourceType": resourceType,
+		"statusCode":   resp.StatusCode,
+		"durationMs":   time.Since(now).Milliseconds(),
+	}, nil
+}
+
+func newWebhookListenCmd() *cobra.Command {
+	var (
+		host      string
+		port      int
+		secret    string
+		execs     []string
+		tolerance time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Run a local webhook receiver",
+		Long: `Run an HTTP server that receives Linear webhook deliveries.
+
+Every delivery is verified against the Linear-Signature HMAC and its
+webhookTimestamp must be within --tolerance of the local clock, which
+rejects replayed deliveries. Issue, Comment and Project payloads are
+decoded into the same shapes used by 'linear watch'.
+
+By default each event is written to stdout as one NDJSON line:
+  {"action": "update", "type": "Issue", "webhookTimestamp": ..., "data": {...}, "updatedFrom": {...}}
+
+Use --exec to run a command per event type instead. The key is a type
+(Issue), a type and action (Issue.create), or * for everything. The event
+JSON is passed on stdin, with LINEAR_WEBHOOK_TYPE and LINEAR_WEBHOOK_ACTION
+set in the environment. Command output goes to stderr.
+
+The secret can also be set with LINEAR_WEBHOOK_SECRET.
+
+Examples:
+  linear webhook listen --port 8080 --secret whsec_...
+  linear webhook listen --exec Issue.create=./triage.sh --exec Comment=./notify.sh
+  linear webhook listen --host 0.0.0.0 --port 9000`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if secret == "" {
+				secret = os.Getenv("LINEAR_WEBHOOK_SECRET")
+			}
+			if secret == "" {
+				if IsHumanOutput() {
+					output.ErrorHumanWithHint(
+						"Webhook signing secret is required",
+						"Copy the signing secret from the webhook settings in Linear",
+						"linear webhook listen --secret <secret>",
+						"export LINEAR_WEBHOOK_SECRET=<secret>",
+					)
+					return nil
+				}
+				return output.ErrorWithHint(
+					"MISSING_SECRET",
+					"Webhook signing secret is required",
+					"Copy the signing secret from the webhook settings in Linear",
+					"linear webhook listen --secret <secret>",
+					"export LINEAR_WEBHOOK_SECRET=<secret>",
+				)
+			}
+
+			handlers, err := parseWebhookExecs(execs)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("INVALID_EXEC", err.Error())
+			}
+
+			output.RegisterSecret(secret)
+
+			receiver := &webhookReceiver{
+				secret:    secret,
+				tolerance: tolerance,
+				handlers:  handlers,
+				encoder:   json.NewEncoder(output.Stdout),
+			}
+
+			addr := net.JoinHostPort(host, strconv.Itoa(port))
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("LISTEN_ERROR", err.Error())
+			}
+
+			server := &http.Server{
+				Handler:           receiver,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			ctx := cmd.Context()
+
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				server.Shutdown(shutdownCtx)
+			}()
+
+			fmt.Fprintf(output.Stderr, "Listening for Linear webhooks on http://%s\n", listener.Addr())
+
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				if IsHumanOutput() {
+					output.ErrorHuman(err.Error())
+					return nil
+				}
+				return output.Error("LISTEN_ERROR", err.Error())
+			}
+
+			receiver.wait()
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Address to bind")
+	cmd.Flags().IntVarP(&port, "port", "p", 8080, "Port to listen on")
+	cmd.Flags().StringVar(&secret, "secret", "", "Webhook signing secret")
+	cmd.Flags().StringArrayVar(&execs, "exec", nil, "Run a command per event type (Type[.action]=command, repeatable)")
+	cmd.Flags().DurationVar(&tolerance, "tolerance", webhook.DefaultTolerance, "Maximum clock drift for webhookTimestamp")
+
+	return cmd
+}
+
+// parseWebhookExecs parses Type[.action]=command pairs
+func parseWebhookExecs(execs []string) (map[string]string, error) {
+	handlers := make(map[string]string, len(execs))
+	for _, e := range execs {
+		key, command, ok := strings.Cut(e, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" || strings.TrimSpace(command) == "" {
+			return nil, fmt.Errorf("invalid --exec value %q (expected Type=command)", e)
+		}
+		handlers[key] = command
+	}
+	return handlers, nil
+}
+
+// webhookReceiver verifies, decodes and dispatches webhook deliveries
+type webhookReceiver struct {
+	secret    string
+	tolerance time.Duration
+	handlers  map[string]string
+	encoder   *json.Encoder
+
+	mu      sync.Mutex
+	running sync.WaitGroup
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxWebhookBody))
+	if err != nil {
+		r.reject(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := webhook.Verify(body, req.Header.Get(webhook.SignatureHeader), r.secret); err != nil {
+		r.reject(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	event, err := webhook.Decode(body, time.Now(), r.tolerance)
+	if err != nil {
+		r.reject(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Acknowledge quickly; Linear retries slow deliveries
+	w.WriteHeader(http.StatusOK)
+
+	command := r.handlerFor(event)
+	if command == "" {
+		r.mu.Lock()
+		r.encoder.Encode(event)
+		r.mu.Unlock()
+		return
+	}
+
+	r.running.Add(1)
+	go func() {
+		defer r.running.Done()
+		if err := runWebhookCommand(command, event); err != nil {
+			r.logf("%s.%s handler failed: %v", event.Type, event.Action, err)
+		}
+	}()
+}
+
+// handlerFor returns the command for an event, most specific key first
+func (r *webhookReceiver) handlerFor(event *webhook.Event) string {
+	for _, key := range []string{event.Type + "." + event.Action, event.Type, "*"} {
+		if command, ok := r.handlers[key]; ok {
+			return command
+		}
+	}
+	return ""
+}
+
+func (r *webhookReceiver) reject(w http.ResponseWriter, status int, err error) {
+	r.logf("rejected delivery: %v", err)
+	http.Error(w, err.Error(), status)
+}
+
+func (r *webhookReceiver) logf(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fmt.Fprintf(output.Stderr, format+"\n", args...)
+}
+
+// wait blocks until running handlers finish
+func (r *webhookReceiver) wait() {
+	r.running.Wait()
+}
+
+func runWebhookCommand(command string, event *webhook.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	c := exec.Command("sh", "-c", command)
+	c.Stdin = strings.NewReader(string(payload))
+	c.Stdout = os.Stderr
+	c.Stderr = os.Stderr
+	c.Env = append(os.Environ(),
+		"LINEAR_WEBHOOK_TYPE="+event.Type,
+		"LINEAR_WEBHOOK_ACTION="+event.Action,
+	)
+
+	return c.Run()
+}
+
+// Human output formatters
+
+func printWebhooksHuman(webhooks *api.WebhooksResponse) {
+	if len(webhooks.Webhooks) == 0 {
+		output.HumanLn("No webhooks found")
+		return
+	}
+
+	headers := []string{"LABEL", "URL", "SCOPE", "RESOURCES", "ENABLED", "ID"}
+	rows := make([][]string, len(webhooks.Webhooks))
+
+	for i, w := range webhooks.Webhooks {
+		label := w.Label
+		if label == "" {
+			label = "-"
+		}
+
+		scope := "-"
+		if w.AllPublicTeams {
+			scope = "All public teams"
+		} else if w.Team != nil {
+			scope = w.Team.Key
+		}
+
+		enabled := output.Green("yes")
+		if !w.Enabled {
+			enabled = output.Muted("no")
+		}
+
+		rows[i] = []string{
+			display.Truncate(label, 24),
+			display.TruncateMiddle(w.URL, 40),
+			scope,
+			display.Truncate(strings.Join(w.ResourceTypes, ", "), 30),
+			enabled,
+			output.Muted("%s", w.ID),
+		}
+	}
+
+	output.TableWithColors(headers, rows)
+	output.HumanLn("\n%d webhooks", webhooks.Count)
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/fatih/color"
@@ -40,7 +39,7 @@ Examples:
   linear whoami
   linear whoami --human`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			// Get auth status first
 			authManager := auth.NewManager()
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"sort"
 
@@ -63,7 +62,7 @@ Examples:
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
@@ -166,7 +165,7 @@ Examples:
 				return output.Error("MISSING_TEAM", "Team is required. Use --team flag or configure default team.")
 			}
 
-			ctx := context.Background()
+			ctx := cmd.Context()
 
 			client, err := api.NewClient(ctx)
 			if err != nil {
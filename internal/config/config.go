@@ -18,6 +18,13 @@ type Config struct {
 	APIKey  string `toml:"api_key"`
 	TeamID  string `toml:"team_id"`
 	TeamKey string `toml:"team_key"`
+
+	// Network settings, see NetworkConfig
+	ProxyURL      string `toml:"proxy_url,omitempty"`
+	CABundle      string `toml:"ca_bundle,omitempty"`
+	ClientCert    string `toml:"client_cert,omitempty"`
+	ClientKey     string `toml:"client_key,omitempty"`
+	TLSMinVersion string `toml:"tls_min_version,omitempty"`
 }
 
 // Manager handles configuration loading and saving
@@ -106,6 +113,16 @@ func (m *Manager) Get(key string) (string, error) {
 		return cfg.TeamID, nil
 	case "team_key":
 		return cfg.TeamKey, nil
+	case "proxy_url":
+		return cfg.ProxyURL, nil
+	case "ca_bundle":
+		return cfg.CABundle, nil
+	case "client_cert":
+		return cfg.ClientCert, nil
+	case "client_key":
+		return cfg.ClientKey, nil
+	case "tls_min_version":
+		return cfg.TLSMinVersion, nil
 	default:
 		return "", fmt.Errorf("unknown config key: %s", key)
 	}
@@ -125,6 +142,19 @@ func (m *Manager) Set(key, value string) error {
 		cfg.TeamID = value
 	case "team_key":
 		cfg.TeamKey = value
+	case "proxy_url":
+		cfg.ProxyURL = value
+	case "ca_bundle":
+		cfg.CABundle = value
+	case "client_cert":
+		cfg.ClientCert = value
+	case "client_key":
+		cfg.ClientKey = value
+	case "tls_min_version":
+		if _, err := ParseTLSVersion(value); err != nil {
+			return err
+		}
+		cfg.TLSMinVersion = value
 	default:
 		return fmt.Errorf("unknown config key: %s", key)
 	}
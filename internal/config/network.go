@@ -0,0 +1,171 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/output"
+)
+
+// NetworkConfig holds proxy and TLS settings for requests to Linear.
+// Environment variables override the config file:
+//
+//	LINEAR_PROXY_URL        proxy_url        HTTP(S) proxy (default: HTTPS_PROXY/NO_PROXY)
+//	LINEAR_CA_BUNDLE        ca_bundle        PEM file of extra trusted CAs
+//	LINEAR_CLIENT_CERT      client_cert      PEM client certificate for mTLS
+//	LINEAR_CLIENT_KEY       client_key       PEM private key for client_cert
+//	LINEAR_TLS_MIN_VERSION  tls_min_version  Minimum TLS version (1.2 or 1.3)
+type NetworkConfig struct {
+	ProxyURL      string `json:"proxyUrl,omitempty"`
+	CABundle      string `json:"caBundle,omitempty"`
+	ClientCert    string `json:"clientCert,omitempty"`
+	ClientKey     string `json:"clientKey,omitempty"`
+	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+}
+
+// Network returns the network settings with environment overrides applied
+func (c *Config) Network() NetworkConfig {
+	n := NetworkConfig{
+		ProxyURL:      c.ProxyURL,
+		CABundle:      c.CABundle,
+		ClientCert:    c.ClientCert,
+		ClientKey:     c.ClientKey,
+		TLSMinVersion: c.TLSMinVersion,
+	}
+
+	for env, field := range map[string]*string{
+		"LINEAR_PROXY_URL":       &n.ProxyURL,
+		"LINEAR_CA_BUNDLE":       &n.CABundle,
+		"LINEAR_CLIENT_CERT":     &n.ClientCert,
+		"LINEAR_CLIENT_KEY":      &n.ClientKey,
+		"LINEAR_TLS_MIN_VERSION": &n.TLSMinVersion,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*field = v
+		}
+	}
+
+	return n
+}
+
+// Redacted returns a copy safe to print, with any proxy password masked
+func (n NetworkConfig) Redacted() NetworkConfig {
+	n.ProxyURL = RedactProxyURL(n.ProxyURL)
+	return n
+}
+
+// RedactProxyURL masks the password in a proxy URL's userinfo. Values that
+// don't parse are returned unchanged.
+func RedactProxyURL(raw string) string {
+	proxy, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return proxy.Redacted()
+}
+
+// ParseTLSVersion converts "1.2" or "1.3" to a crypto/tls version
+func ParseTLSVersion(value string) (uint16, error) {
+	switch value {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid TLS version %q (use 1.2 or 1.3)", value)
+	}
+}
+
+// Transport builds an HTTP transport with the proxy and TLS settings
+func (n NetworkConfig) Transport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if n.ProxyURL != "" {
+		proxy, err := url.Parse(n.ProxyURL)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q", n.ProxyURL)
+		}
+		if password, ok := proxy.User.Password(); ok {
+			output.RegisterSecret(password)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	minVersion, err := ParseTLSVersion(n.TLSMinVersion)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{MinVersion: minVersion}
+
+	if n.CABundle != "" {
+		pem, err := os.ReadFile(n.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", n.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if n.ClientCert != "" || n.ClientKey != "" {
+		if n.ClientCert == "" || n.ClientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(n.ClientCert, n.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+var (
+	transportOnce sync.Once
+	transport     *http.Transport
+	transportErr  error
+)
+
+// HTTPTransport returns the shared transport for requests to Linear, built
+// from the config file and environment on first use
+func HTTPTransport() (*http.Transport, error) {
+	transportOnce.Do(func() {
+		manager, err := NewManager()
+		if err != nil {
+			transportErr = err
+			return
+		}
+		cfg, err := manager.Load()
+		if err != nil {
+			transportErr = err
+			return
+		}
+		transport, transportErr = cfg.Network().Transport()
+		if transportErr != nil {
+			transportErr = fmt.Errorf("network config: %w", transportErr)
+		}
+	})
+	return transport, transportErr
+}
+
+// HTTPClient returns an HTTP client using HTTPTransport
+func HTTPClient(timeout time.Duration) (*http.Client, error) {
+	t, err := HTTPTransport()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: t, Timeout: timeout}, nil
+}
@@ -0,0 +1,85 @@
+package display
+
+import (
+	"strings"
+	"time"
+)
+
+// Timeline maps dates onto a fixed number of character columns for
+// Gantt-style charts
+type Timeline struct {
+	Start time.Time
+	End   time.Time
+	Width int
+}
+
+// NewTimeline returns a timeline covering start through end (inclusive),
+// widened to at least one day
+func NewTimeline(start, end time.Time, width int) Timeline {
+	start = truncateDay(start)
+	end = truncateDay(end)
+	if !end.After(start) {
+		end = start.AddDate(0, 0, 1)
+	}
+	if width < 10 {
+		width = 10
+	}
+	return Timeline{Start: start, End: end, Width: width}
+}
+
+// Fraction returns t's position on the timeline from 0 (start) to 1 (end),
+// clamped to that range
+func (tl Timeline) Fraction(t time.Time) float64 {
+	span := tl.End.Sub(tl.Start)
+	f := float64(truncateDay(t).Sub(tl.Start)) / float64(span)
+	if f < 0 {
+		return 0
+	}
+	if f > 1 {
+		return 1
+	}
+	return f
+}
+
+// Column returns the character column for t
+func (tl Timeline) Column(t time.Time) int {
+	return int(tl.Fraction(t) * float64(tl.Width-1))
+}
+
+// Months returns the first day of each month within the timeline
+func (tl Timeline) Months() []time.Time {
+	var months []time.Time
+	m := time.Date(tl.Start.Year(), tl.Start.Month(), 1, 0, 0, 0, 0, tl.Start.Location())
+	if m.Before(tl.Start) {
+		m = m.AddDate(0, 1, 0)
+	}
+	for ; !m.After(tl.End); m = m.AddDate(0, 1, 0) {
+		months = append(months, m)
+	}
+	return months
+}
+
+// Axis returns a header line with month labels ("Jan", or "Jan 06" at
+// year boundaries) placed at their columns
+func (tl Timeline) Axis() string {
+	line := []rune(strings.Repeat(" ", tl.Width))
+	next := 0
+	for _, m := range tl.Months() {
+		label := m.Format("Jan")
+		if m.Month() == time.January {
+			label = m.Format("Jan 06")
+		}
+		col := tl.Column(m)
+		if col < next || col+len(label) > tl.Width {
+			continue
+		}
+		copy(line[col:], []rune(label))
+		next = col + len(label) + 1
+	}
+	return string(line)
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
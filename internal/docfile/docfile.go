@@ -0,0 +1,194 @@
+// Package docfile reads and writes Linear documents as Markdown files with
+// YAML front matter
+package docfile
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// delimiter opens and closes the front matter block
+const delimiter = "---"
+
+// FrontMatter is the document metadata stored at the top of a file. Its
+// keys are flat "key: value" pairs.
+type FrontMatter struct {
+	ID        string `json:"id,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Project   string `json:"project,omitempty"` // project ID
+	Icon      string `json:"icon,omitempty"`
+	Color     string `json:"color,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// File is a parsed document file
+type File struct {
+	FrontMatter FrontMatter
+	// Extra holds front matter lines for keys other than FrontMatter's,
+	// such as a static site generator's layout or tags, with any nested
+	// lines and comments. They are written back unchanged.
+	Extra   []string
+	Content string
+}
+
+// fields lists front matter keys in the order they are written
+func (fm *FrontMatter) fields() []struct {
+	key   string
+	value *string
+} {
+	return []struct {
+		key   string
+		value *string
+	}{
+		{"id", &fm.ID},
+		{"title", &fm.Title},
+		{"project", &fm.Project},
+		{"icon", &fm.Icon},
+		{"color", &fm.Color},
+		{"updatedAt", &fm.UpdatedAt},
+	}
+}
+
+// Format renders the file as front matter followed by the Markdown content.
+// Empty fields are omitted and the content always ends with a newline.
+func Format(f File) []byte {
+	var b strings.Builder
+	b.WriteString(delimiter + "\n")
+	for _, field := range f.FrontMatter.fields() {
+		if *field.value == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "%s: %s\n", field.key, quote(*field.value))
+	}
+	for _, line := range f.Extra {
+		b.WriteString(line + "\n")
+	}
+	b.WriteString(delimiter + "\n")
+
+	b.WriteString(f.Content)
+	if f.Content != "" && !strings.HasSuffix(f.Content, "\n") {
+		b.WriteString("\n")
+	}
+	return []byte(b.String())
+}
+
+// Parse splits a file into front matter and content. A file without front
+// matter is all content. Lines for unknown keys, including their indented
+// or list continuation lines, and comments are kept in Extra.
+func Parse(data []byte) (*File, error) {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	if !strings.HasPrefix(text, delimiter+"\n") {
+		return &File{Content: text}, nil
+	}
+
+	rest := text[len(delimiter)+1:]
+	end := -1
+	offset := 0
+	for _, line := range strings.SplitAfter(rest, "\n") {
+		if strings.TrimRight(line, "\n") == delimiter {
+			end = offset
+			break
+		}
+		offset += len(line)
+	}
+	if end < 0 {
+		return nil, errors.New("front matter is not closed with ---")
+	}
+
+	f := &File{Content: rest[end+len(delimiter):]}
+	f.Content = strings.TrimPrefix(f.Content, "\n")
+
+	values := map[string]*string{}
+	for _, field := range f.FrontMatter.fields() {
+		values[field.key] = field.value
+	}
+
+	// inExtra tracks whether continuation lines belong to an unknown key
+	inExtra := false
+	for i, line := range strings.Split(strings.TrimSuffix(rest[:end], "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			f.Extra = append(f.Extra, line)
+			continue
+		case line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(line, "- "):
+			if !inExtra {
+				return nil, fmt.Errorf("front matter line %d: nested values are only supported for unknown keys", i+2)
+			}
+			f.Extra = append(f.Extra, line)
+			continue
+		}
+
+		key, raw, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("front matter line %d: expected key: value", i+2)
+		}
+		target, known := values[strings.TrimSpace(key)]
+		inExtra = !known
+		if !known {
+			f.Extra = append(f.Extra, line)
+			continue
+		}
+		value, err := unquote(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("front matter line %d: %w", i+2, err)
+		}
+		*target = value
+	}
+
+	return f, nil
+}
+
+// quote renders a YAML scalar, double-quoting anything that isn't a plain
+// word. Go's escapes are a subset of YAML's double-quoted escapes.
+func quote(s string) string {
+	plain := s != "" && !strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`0123456789 ")
+	for _, r := range s {
+		if !(r == '_' || r == '-' || r == '.' || r == ' ' ||
+			(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+			plain = false
+			break
+		}
+	}
+	if plain && !strings.HasSuffix(s, " ") && !isReserved(s) {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+// isReserved reports whether a plain scalar would be read as a non-string
+func isReserved(s string) bool {
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~":
+		return true
+	}
+	return false
+}
+
+// unquote parses a plain, single-quoted, or double-quoted scalar
+func unquote(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid quoted value %s", s)
+		}
+		return v, nil
+	case strings.HasPrefix(s, "'"):
+		if len(s) < 2 || !strings.HasSuffix(s, "'") {
+			return "", fmt.Errorf("invalid quoted value %s", s)
+		}
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+
+	// Trailing comments on plain scalars
+	if i := strings.Index(s, " #"); i >= 0 {
+		s = strings.TrimSpace(s[:i])
+	}
+	return s, nil
+}
@@ -0,0 +1,101 @@
+package docfile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		file File
+	}{
+		{
+			name: "all fields",
+			file: File{
+				FrontMatter: FrontMatter{
+					ID:        "abc-123",
+					Title:     "Spec: v2 #1",
+					Project:   "proj-1",
+					Icon:      "Rocket",
+					Color:     "#ff0000",
+					UpdatedAt: "2026-01-02T03:04:05.000Z",
+				},
+				Content: "# Spec\n\nBody\n",
+			},
+		},
+		{
+			name: "values that need quoting",
+			file: File{
+				FrontMatter: FrontMatter{
+					Title: `"true" - it's 50% done`,
+					Icon:  "true",
+					Color: "- dash",
+				},
+				Content: "---\nnot front matter\n",
+			},
+		},
+		{
+			name: "empty content",
+			file: File{FrontMatter: FrontMatter{ID: "abc"}},
+		},
+		{
+			name: "unknown keys",
+			file: File{
+				FrontMatter: FrontMatter{ID: "abc", Title: "Spec"},
+				Extra:       []string{"layout: post", "# kept comment", "tags:", "  - a", "  - b"},
+				Content:     "Body\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := Parse(Format(tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*parsed, tt.file) {
+				t.Errorf("round trip = %+v, want %+v", *parsed, tt.file)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	f, err := Parse([]byte("# No front matter\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.FrontMatter != (FrontMatter{}) || f.Extra != nil || f.Content != "# No front matter\n" {
+		t.Errorf("Parse() = %+v", *f)
+	}
+
+	f, err = Parse([]byte("---\nid: abc # comment\ntitle: 'It''s'\nunknown: x\n---\nBody\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.FrontMatter.ID != "abc" || f.FrontMatter.Title != "It's" || f.Content != "Body\n" {
+		t.Errorf("Parse() = %+v", *f)
+	}
+	if want := []string{"unknown: x"}; !reflect.DeepEqual(f.Extra, want) {
+		t.Errorf("Extra = %q, want %q", f.Extra, want)
+	}
+
+	data := "---\nid: abc\nlayout: post\ntags:\n  - a\n---\nBody\n"
+	f, err = Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(Format(*f)); got != data {
+		t.Errorf("Format(Parse()) = %q, want %q", got, data)
+	}
+
+	if _, err := Parse([]byte("---\nid: abc\n  nested: x\n---\n")); err == nil {
+		t.Error("expected an error for a nested value under a known key")
+	}
+
+	if _, err := Parse([]byte("---\nid: abc\n")); err == nil {
+		t.Error("expected an error for unclosed front matter")
+	}
+}
@@ -0,0 +1,96 @@
+package docfile
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LockFileName is the lock file kept at the root of a synced directory
+const LockFileName = ".linear-sync.json"
+
+// Lock maps the files in a synced directory to Linear documents
+type Lock struct {
+	Project string `json:"project"`
+	// Documents is keyed by slash-separated path relative to the directory
+	Documents map[string]LockEntry `json:"documents"`
+}
+
+// LockEntry records a document as of the last sync
+type LockEntry struct {
+	ID        string `json:"id"`
+	UpdatedAt string `json:"updatedAt"`
+	// Title is the title at the last sync, to tell which side renamed the
+	// document
+	Title string `json:"title,omitempty"`
+	// Base is the content at the last sync, the common ancestor when both
+	// sides have changed
+	Base string `json:"base"`
+}
+
+// ReadLock loads the lock file in dir. A missing lock file is an empty lock.
+func ReadLock(dir string) (*Lock, error) {
+	lock := &Lock{Documents: map[string]LockEntry{}}
+
+	data, err := os.ReadFile(filepath.Join(dir, LockFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return lock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, errors.New(LockFileName + ": " + err.Error())
+	}
+	if lock.Documents == nil {
+		lock.Documents = map[string]LockEntry{}
+	}
+	return lock, nil
+}
+
+// WriteLock saves the lock file in dir
+func WriteLock(dir string, lock *Lock) error {
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, LockFileName), append(data, '\n'), 0644)
+}
+
+// ListFiles returns the Markdown files under dir as sorted, slash-separated
+// relative paths. Hidden files and directories are skipped.
+func ListFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".md") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
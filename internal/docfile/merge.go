@@ -0,0 +1,311 @@
+package docfile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// maxLCSCells caps the LCS table at 16 MB of int32s. Larger changed
+// regions are treated as one replacement rather than diffed line by line.
+const maxLCSCells = 4 << 20
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+// op is one step of a line edit script
+type op struct {
+	kind opKind
+	line string
+}
+
+// hunk replaces base lines [start, end) with lines
+type hunk struct {
+	start, end int
+	lines      []string
+}
+
+// Diff returns a unified diff from a to b, or "" if they are equal
+func Diff(fromName, toName, a, b string) string {
+	ops := editScript(splitLines(a), splitLines(b))
+
+	// Lines of a and b consumed before each op, for hunk headers
+	aBefore := make([]int, len(ops)+1)
+	bBefore := make([]int, len(ops)+1)
+	for i, o := range ops {
+		aBefore[i+1], bBefore[i+1] = aBefore[i], bBefore[i]
+		if o.kind != opInsert {
+			aBefore[i+1]++
+		}
+		if o.kind != opDelete {
+			bBefore[i+1]++
+		}
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+
+		// Extend the hunk over changes separated by short unchanged runs
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != opEqual {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == opEqual {
+				next++
+			}
+			if next == len(ops) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+
+		start := max(i-diffContext, 0)
+		stop := min(end+diffContext, len(ops))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n",
+			hunkRange(aBefore[start], aBefore[stop]), hunkRange(bBefore[start], bBefore[stop]))
+		for _, o := range ops[start:stop] {
+			switch o.kind {
+			case opEqual:
+				sb.WriteString(" " + o.line + "\n")
+			case opDelete:
+				sb.WriteString("-" + o.line + "\n")
+			case opInsert:
+				sb.WriteString("+" + o.line + "\n")
+			}
+		}
+
+		i = stop
+	}
+
+	return sb.String()
+}
+
+// Merge applies both local and remote edits of base, line by line. Edits
+// to separate parts of the text are combined; overlapping edits that
+// differ are kept as a diff3-style conflict block. It reports whether the
+// merge is free of conflicts.
+func Merge(base, local, remote string) (string, bool) {
+	baseLines := splitLines(base)
+
+	type sidedHunk struct {
+		hunk
+		remote bool
+	}
+	var all []sidedHunk
+	for _, h := range hunks(baseLines, splitLines(local)) {
+		all = append(all, sidedHunk{h, false})
+	}
+	for _, h := range hunks(baseLines, splitLines(remote)) {
+		all = append(all, sidedHunk{h, true})
+	}
+	sort.SliceStable(all, func(i, j int) bool { return all[i].start < all[j].start })
+
+	var merged []string
+	clean := true
+	pos := 0
+	for i := 0; i < len(all); {
+		// Hunks that overlap or touch are resolved together
+		lo, hi := all[i].start, all[i].end
+		j := i + 1
+		for j < len(all) && all[j].start <= hi {
+			hi = max(hi, all[j].end)
+			j++
+		}
+
+		var localHunks, remoteHunks []hunk
+		for _, h := range all[i:j] {
+			if h.remote {
+				remoteHunks = append(remoteHunks, h.hunk)
+			} else {
+				localHunks = append(localHunks, h.hunk)
+			}
+		}
+		i = j
+
+		merged = append(merged, baseLines[pos:lo]...)
+		pos = hi
+
+		localLines := applyHunks(baseLines, lo, hi, localHunks)
+		remoteLines := applyHunks(baseLines, lo, hi, remoteHunks)
+		switch {
+		case len(remoteHunks) == 0:
+			merged = append(merged, localLines...)
+		case len(localHunks) == 0:
+			merged = append(merged, remoteLines...)
+		case strings.Join(localLines, "\n") == strings.Join(remoteLines, "\n"):
+			merged = append(merged, localLines...)
+		default:
+			clean = false
+			merged = append(merged, "<<<<<<< local")
+			merged = append(merged, localLines...)
+			merged = append(merged, "||||||| base")
+			merged = append(merged, baseLines[lo:hi]...)
+			merged = append(merged, "=======")
+			merged = append(merged, remoteLines...)
+			merged = append(merged, ">>>>>>> remote")
+		}
+	}
+	merged = append(merged, baseLines[pos:]...)
+
+	if len(merged) == 0 {
+		return "", clean
+	}
+	return strings.Join(merged, "\n") + "\n", clean
+}
+
+// hunks returns the changed regions turning base into other
+func hunks(base, other []string) []hunk {
+	var result []hunk
+	var current *hunk
+	pos := 0
+	for _, o := range editScript(base, other) {
+		if o.kind == opEqual {
+			if current != nil {
+				result = append(result, *current)
+				current = nil
+			}
+			pos++
+			continue
+		}
+		if current == nil {
+			current = &hunk{start: pos, end: pos}
+		}
+		if o.kind == opDelete {
+			current.end++
+			pos++
+		} else {
+			current.lines = append(current.lines, o.line)
+		}
+	}
+	if current != nil {
+		result = append(result, *current)
+	}
+	return result
+}
+
+// applyHunks returns base[lo:hi] with the hunks, which lie within that
+// range in order, applied
+func applyHunks(base []string, lo, hi int, hs []hunk) []string {
+	var out []string
+	pos := lo
+	for _, h := range hs {
+		out = append(out, base[pos:h.start]...)
+		out = append(out, h.lines...)
+		pos = h.end
+	}
+	return append(out, base[pos:hi]...)
+}
+
+// editScript returns a shortest line edit script turning a into b, with
+// deletions before insertions in each changed region
+func editScript(a, b []string) []op {
+	// Trimming the common prefix and suffix keeps the LCS table small for
+	// typical edits
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{opEqual, line})
+	}
+	ops = append(ops, lcsScript(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{opEqual, line})
+	}
+	return ops
+}
+
+// lcsScript diffs a and b through their longest common subsequence. If the
+// table would exceed maxLCSCells, all of a is replaced by all of b.
+func lcsScript(a, b []string) []op {
+	n, m := len(a), len(b)
+	ops := make([]op, 0, n+m)
+	if (n+1)*(m+1) > maxLCSCells {
+		for _, line := range a {
+			ops = append(ops, op{opDelete, line})
+		}
+		for _, line := range b {
+			ops = append(ops, op{opInsert, line})
+		}
+		return ops
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	for i, j := 0, 0; i < n || j < m; {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			ops = append(ops, op{opEqual, a[i]})
+			i++
+			j++
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, op{opDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, b[j]})
+			j++
+		}
+	}
+	return ops
+}
+
+// splitLines splits text into lines without their line endings
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// hunkRange formats a unified diff range from the lines consumed before
+// and after the hunk
+func hunkRange(before, after int) string {
+	count := after - before
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
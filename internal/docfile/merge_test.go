@@ -0,0 +1,143 @@
+package docfile
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		base   string
+		local  string
+		remote string
+		want   string
+		clean  bool
+	}{
+		{
+			name:   "separate edits",
+			base:   "1\n2\n3\n4\n5\n",
+			local:  "L\n2\n3\n4\n5\n",
+			remote: "1\n2\n3\n4\nR\n",
+			want:   "L\n2\n3\n4\nR\n",
+			clean:  true,
+		},
+		{
+			name:   "one side only",
+			base:   "a\nb\n",
+			local:  "a\nb\n",
+			remote: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+			clean:  true,
+		},
+		{
+			name:   "identical edits",
+			base:   "a\nb\nc\n",
+			local:  "a\nB\nc\n",
+			remote: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+			clean:  true,
+		},
+		{
+			name:   "identical insertions",
+			base:   "a\nb\n",
+			local:  "a\nX\nb\n",
+			remote: "a\nX\nb\n",
+			want:   "a\nX\nb\n",
+			clean:  true,
+		},
+		{
+			name:   "insertions at the same position",
+			base:   "a\nb\n",
+			local:  "a\nX\nb\n",
+			remote: "a\nY\nb\n",
+			want:   "a\n<<<<<<< local\nX\n||||||| base\n=======\nY\n>>>>>>> remote\nb\n",
+			clean:  false,
+		},
+		{
+			name:   "overlapping edits",
+			base:   "a\nb\nc\n",
+			local:  "a\nL\nc\n",
+			remote: "a\nR\nc\n",
+			want:   "a\n<<<<<<< local\nL\n||||||| base\nb\n=======\nR\n>>>>>>> remote\nc\n",
+			clean:  false,
+		},
+		{
+			name:   "delete vs edit",
+			base:   "a\nb\nc\n",
+			local:  "a\nc\n",
+			remote: "a\nB\nc\n",
+			want:   "a\n<<<<<<< local\n||||||| base\nb\n=======\nB\n>>>>>>> remote\nc\n",
+			clean:  false,
+		},
+		{
+			name:   "empty base, both sides added",
+			base:   "",
+			local:  "x\n",
+			remote: "y\n",
+			want:   "<<<<<<< local\nx\n||||||| base\n=======\ny\n>>>>>>> remote\n",
+			clean:  false,
+		},
+		{
+			name:   "empty base, one side added",
+			base:   "",
+			local:  "x\n",
+			remote: "",
+			want:   "x\n",
+			clean:  true,
+		},
+		{
+			name:  "all empty",
+			clean: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, clean := Merge(tt.base, tt.local, tt.remote)
+			if got != tt.want || clean != tt.clean {
+				t.Errorf("Merge() = %q, %t; want %q, %t", got, clean, tt.want, tt.clean)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	if got := Diff("a", "b", "same\n", "same\n"); got != "" {
+		t.Errorf("Diff of equal text = %q, want empty", got)
+	}
+
+	got := Diff("remote", "local", "1\n2\n3\n", "1\nX\n3\n")
+	want := "--- remote\n+++ local\n@@ -1,3 +1,3 @@\n 1\n-2\n+X\n 3\n"
+	if got != want {
+		t.Errorf("Diff() = %q, want %q", got, want)
+	}
+}
+
+func TestEditScriptLargeChange(t *testing.T) {
+	// Past maxLCSCells the changed region is replaced as a whole, which
+	// must still turn a into b
+	var a, b []string
+	for i := 0; i < 2100; i++ {
+		a = append(a, fmt.Sprintf("a%d", i))
+		b = append(b, fmt.Sprintf("b%d", i))
+	}
+	a = append([]string{"head"}, append(a, "tail")...)
+	b = append([]string{"head"}, append(b, "tail")...)
+
+	var out []string
+	for _, o := range editScript(a, b) {
+		if o.kind != opDelete {
+			out = append(out, o.line)
+		}
+	}
+	if strings.Join(out, "\n") != strings.Join(b, "\n") {
+		t.Fatal("edit script does not produce b")
+	}
+
+	local := strings.Join(b, "\n") + "\n"
+	if got, clean := Merge(strings.Join(a, "\n")+"\n", local, strings.Join(a, "\n")+"\n"); !clean || got != local {
+		t.Error("one-sided large change did not merge cleanly")
+	}
+}
@@ -3,6 +3,7 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -31,9 +32,13 @@ type SuccessResponse struct {
 	Message   string `json:"message,omitempty"`
 }
 
+// Stdout carries API payloads, which may legitimately contain text that
+// looks like a token, so only registered secrets are masked there
+var Stdout io.Writer = &redactingWriter{w: os.Stdout, redact: RedactSecrets}
+
 // JSON outputs data as formatted JSON to stdout
 func JSON(data interface{}) error {
-	encoder := json.NewEncoder(os.Stdout)
+	encoder := json.NewEncoder(Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(data)
 }
@@ -44,17 +49,17 @@ func JSONString(data interface{}) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return string(bytes), nil
+	return RedactSecrets(string(bytes)), nil
 }
 
 // Human outputs human-readable text to stdout
 func Human(format string, args ...interface{}) {
-	fmt.Printf(format, args...)
+	fmt.Fprintf(Stdout, format, args...)
 }
 
 // HumanLn outputs human-readable text with newline
 func HumanLn(format string, args ...interface{}) {
-	fmt.Printf(format+"\n", args...)
+	fmt.Fprintf(Stdout, format+"\n", args...)
 }
 
 // Error outputs an error response
@@ -63,7 +68,7 @@ func Error(code, message string) error {
 		Success: false,
 		Error: &ErrorInfo{
 			Code:    code,
-			Message: message,
+			Message: Redact(message),
 		},
 	}
 	return JSON(resp)
@@ -75,9 +80,9 @@ func ErrorWithHint(code, message, hint string, usage ...string) error {
 		Success: false,
 		Error: &ErrorInfo{
 			Code:    code,
-			Message: message,
-			Hint:    hint,
-			Usage:   usage,
+			Message: Redact(message),
+			Hint:    Redact(hint),
+			Usage:   redactAll(usage),
 		},
 	}
 	return JSON(resp)
@@ -85,26 +90,38 @@ func ErrorWithHint(code, message, hint string, usage ...string) error {
 
 // ErrorHuman outputs a human-readable error
 func ErrorHuman(message string) {
-	color.Red("Error: %s", message)
+	color.Red("Error: %s", Redact(message))
 	fmt.Println()
 }
 
 // ErrorHumanWithHint outputs a human-readable error with guidance
 func ErrorHumanWithHint(message, hint string, usage ...string) {
-	color.Red("Error: %s", message)
+	color.Red("Error: %s", Redact(message))
 	fmt.Println()
 	if hint != "" {
-		fmt.Printf("\n%s\n", hint)
+		fmt.Fprintf(Stdout, "\n%s\n", Redact(hint))
 	}
 	if len(usage) > 0 {
 		fmt.Println("\nExamples:")
 		for _, u := range usage {
-			fmt.Printf("  %s\n", u)
+			fmt.Fprintf(Stdout, "  %s\n", Redact(u))
 		}
 	}
 	fmt.Println()
 }
 
+// redactAll redacts each of values
+func redactAll(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	redacted := make([]string, len(values))
+	for i, v := range values {
+		redacted[i] = Redact(v)
+	}
+	return redacted
+}
+
 // Success outputs a success response
 func Success(operation, message string) error {
 	resp := SuccessResponse{
@@ -117,13 +134,13 @@ func Success(operation, message string) error {
 
 // SuccessHuman outputs a human-readable success message
 func SuccessHuman(message string) {
-	color.Green("✓ %s", message)
+	color.Green("✓ %s", RedactSecrets(message))
 	fmt.Println()
 }
 
 // Table outputs data in table format
 func Table(headers []string, rows [][]string) {
-	table := tablewriter.NewWriter(os.Stdout)
+	table := tablewriter.NewWriter(Stdout)
 	table.SetHeader(headers)
 	table.SetBorder(false)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
@@ -146,7 +163,7 @@ func TableWithColors(headers []string, rows [][]string) {
 		coloredHeaders[i] = color.New(color.Bold).Sprint(h)
 	}
 
-	table := tablewriter.NewWriter(os.Stdout)
+	table := tablewriter.NewWriter(Stdout)
 	table.SetHeader(coloredHeaders)
 	table.SetBorder(false)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
@@ -198,7 +215,7 @@ func Cyan(format string, args ...interface{}) string {
 
 // KeyValue outputs a key-value pair for human output
 func KeyValue(key, value string) {
-	fmt.Printf("  %s: %s\n", color.New(color.Faint).Sprint(key), value)
+	fmt.Fprintf(Stdout, "  %s: %s\n", color.New(color.Faint).Sprint(key), value)
 }
 
 // Divider outputs a divider line
@@ -0,0 +1,92 @@
+package output
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RedactedMask replaces secret material in output
+const RedactedMask = "********"
+
+// minSecretLength guards against registering short values that would mask
+// ordinary words
+const minSecretLength = 8
+
+var (
+	// tokenPattern matches Linear API keys and OAuth tokens
+	tokenPattern = regexp.MustCompile(`\b(lin_(?:api|oauth)_)[A-Za-z0-9_\-]+`)
+
+	// authHeaderPattern matches Authorization header values echoed by
+	// transports, e.g. `Authorization: Bearer xyz` or `"Authorization":"xyz"`
+	authHeaderPattern = regexp.MustCompile(`(?i)(authorization"?\s*[:=]\s*\[?"?(?:bearer\s+)?)[^\s",\]]+`)
+
+	secretsMu sync.RWMutex
+	secrets   []string
+)
+
+// Stderr is a redacting writer for diagnostics
+var Stderr io.Writer = NewRedactingWriter(os.Stderr)
+
+// RegisterSecret masks value wherever it appears in later output. Use it
+// for secrets without a recognizable prefix, such as OAuth client secrets.
+func RegisterSecret(value string) {
+	value = strings.TrimSpace(value)
+	if len(value) < minSecretLength {
+		return
+	}
+
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+	for _, s := range secrets {
+		if s == value {
+			return
+		}
+	}
+	secrets = append(secrets, value)
+}
+
+// Redact masks Linear tokens, Authorization header values and registered
+// secrets in s. Use it for diagnostics: errors, warnings and traces.
+func Redact(s string) string {
+	s = tokenPattern.ReplaceAllString(s, "${1}"+RedactedMask)
+	s = authHeaderPattern.ReplaceAllString(s, "${1}"+RedactedMask)
+	return RedactSecrets(s)
+}
+
+// RedactSecrets masks only the values passed to RegisterSecret in s
+func RedactSecrets(s string) string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, RedactedMask)
+	}
+	return s
+}
+
+// redactingWriter masks secrets in everything written through it. Each
+// Write is redacted on its own, so a secret split across two writes is not
+// masked. The writers in this package are fed by json.Encoder, fmt.Fprintf
+// and tablewriter, which emit a whole value per Write; callers that stream
+// partial data must buffer it first.
+type redactingWriter struct {
+	w      io.Writer
+	redact func(string) string
+}
+
+// NewRedactingWriter wraps w so secrets are masked with Redact before they
+// are written
+func NewRedactingWriter(w io.Writer) io.Writer {
+	return &redactingWriter{w: w, redact: Redact}
+}
+
+func (r *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(r.w, r.redact(string(p))); err != nil {
+		return 0, err
+	}
+	// Report the original length so callers don't treat masking as a
+	// short write
+	return len(p), nil
+}
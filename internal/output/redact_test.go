@@ -0,0 +1,190 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestRedact(t *testing.T) {
+	RegisterSecret("client-secret-value")
+	RegisterSecret("short")
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "api key",
+			in:   "key lin_api_AbC123def456",
+			want: "key lin_api_********",
+		},
+		{
+			name: "oauth token",
+			in:   `{"access_token":"lin_oauth_x-Y_z0123"}`,
+			want: `{"access_token":"lin_oauth_********"}`,
+		},
+		{
+			name: "bearer header",
+			in:   "Authorization: Bearer abc.def.ghi",
+			want: "Authorization: Bearer ********",
+		},
+		{
+			name: "bearer header lower case",
+			in:   "authorization=bearer abc",
+			want: "authorization=bearer ********",
+		},
+		{
+			name: "json header",
+			in:   `{"Authorization":"abc123"}`,
+			want: `{"Authorization":"********"}`,
+		},
+		{
+			name: "header list",
+			in:   `"Authorization":["abc123"]`,
+			want: `"Authorization":["********"]`,
+		},
+		{
+			name: "registered secret",
+			in:   "secret=client-secret-value;",
+			want: "secret=********;",
+		},
+		{
+			name: "short values are not registered",
+			in:   "a short message",
+			want: "a short message",
+		},
+		{
+			name: "ordinary text",
+			in:   "lin_api is a prefix",
+			want: "lin_api is a prefix",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Redact(tt.in); got != tt.want {
+				t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactingWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewRedactingWriter(&buf)
+
+	in := "token lin_api_secret123\n"
+	n, err := w.Write([]byte(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(in) {
+		t.Errorf("Write returned %d, want %d", n, len(in))
+	}
+	if got, want := buf.String(), "token lin_api_********\n"; got != want {
+		t.Errorf("wrote %q, want %q", got, want)
+	}
+}
+
+// captureStdout redirects Stdout and the colour output to a buffer, masking
+// Stdout the same way as the real one
+func captureStdout(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	stdout, colorOutput, noColor := Stdout, color.Output, color.NoColor
+	Stdout = &redactingWriter{w: &buf, redact: RedactSecrets}
+	color.Output = &buf
+	color.NoColor = true
+	t.Cleanup(func() {
+		Stdout, color.Output, color.NoColor = stdout, colorOutput, noColor
+	})
+	return &buf
+}
+
+func TestErrorOutputIsRedacted(t *testing.T) {
+	RegisterSecret("registered-secret-1")
+
+	tests := []struct {
+		name  string
+		write func()
+	}{
+		{
+			name: "Error",
+			write: func() {
+				_ = Error("API_ERROR", "request with lin_api_abc123 failed")
+			},
+		},
+		{
+			name: "ErrorWithHint",
+			write: func() {
+				_ = ErrorWithHint("API_ERROR", "Authorization: Bearer abc123 rejected",
+					"use registered-secret-1", "linear auth login --key lin_oauth_abc123")
+			},
+		},
+		{
+			name: "ErrorHuman",
+			write: func() {
+				ErrorHuman("request with lin_api_abc123 and registered-secret-1 failed")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureStdout(t)
+			tt.write()
+
+			got := buf.String()
+			for _, leaked := range []string{"abc123", "registered-secret-1"} {
+				if strings.Contains(got, leaked) {
+					t.Errorf("output contains %q: %s", leaked, got)
+				}
+			}
+			if !strings.Contains(got, RedactedMask) {
+				t.Errorf("output is not masked: %s", got)
+			}
+		})
+	}
+}
+
+func TestErrorOutputIsJSON(t *testing.T) {
+	buf := captureStdout(t)
+	_ = ErrorWithHint("INVALID_FLAGS", "bad lin_api_abc123", "hint", "usage")
+
+	var resp ErrorResponse
+	if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, buf.String())
+	}
+	if resp.Success || resp.Error == nil || resp.Error.Code != "INVALID_FLAGS" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Error.Message != "bad lin_api_********" {
+		t.Errorf("message = %q", resp.Error.Message)
+	}
+}
+
+func TestPayloadOutputIsNotRedacted(t *testing.T) {
+	RegisterSecret("registered-secret-2")
+
+	buf := captureStdout(t)
+	body := "Use lin_api_example in docs\nAuthorization: required for admin"
+	_ = JSON(map[string]string{"content": body, "note": "registered-secret-2"})
+	HumanLn("%s", body)
+	SuccessHuman("Created lin_api_example")
+
+	got := buf.String()
+	if strings.Contains(got, "registered-secret-2") {
+		t.Errorf("registered secret leaked: %s", got)
+	}
+	for _, want := range []string{`lin_api_example in docs\nAuthorization: required for admin`, "Authorization: required for admin\n", "Created lin_api_example"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q: %s", want, got)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+const (
+	// SignatureHeader is the header carrying the hex HMAC-SHA256 of the body
+	SignatureHeader = "Linear-Signature"
+
+	// DefaultTolerance is how far webhookTimestamp may drift from the local
+	// clock before a delivery is rejected as a replay
+	DefaultTolerance = time.Minute
+)
+
+// Event is a decoded Linear webhook delivery
+type Event struct {
+	Action           string                 `json:"action"` // create, update, remove
+	Type             string                 `json:"type"`   // Issue, Comment, Project, ...
+	URL              string                 `json:"url,omitempty"`
+	CreatedAt        string                 `json:"createdAt,omitempty"`
+	WebhookID        string                 `json:"webhookId,omitempty"`
+	WebhookTimestamp int64                  `json:"webhookTimestamp"`
+	OrganizationID   string                 `json:"organizationId,omitempty"`
+	Data             interface{}            `json:"data"`
+	UpdatedFrom      map[string]interface{} `json:"updatedFrom,omitempty"`
+}
+
+// Verify checks the Linear-Signature header against the raw request body
+func Verify(body []byte, signature, secret string) error {
+	if signature == "" {
+		return fmt.Errorf("missing %s header", SignatureHeader)
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("malformed %s header", SignatureHeader)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return fmt.Errorf("signature mismatch")
+	}
+
+	return nil
+}
+
+// Decode parses a delivery body, rejecting stale timestamps, and decodes
+// Issue, Comment and Project payloads into their api types. Other types keep
+// their raw JSON data.
+func Decode(body []byte, now time.Time, tolerance time.Duration) (*Event, error) {
+	var raw struct {
+		Event
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	if raw.WebhookTimestamp == 0 {
+		return nil, fmt.Errorf("missing webhookTimestamp")
+	}
+	sent := time.UnixMilli(raw.WebhookTimestamp)
+	if drift := now.Sub(sent); drift > tolerance || drift < -tolerance {
+		return nil, fmt.Errorf("webhookTimestamp outside tolerance (%s)", drift.Round(time.Second))
+	}
+
+	event := raw.Event
+
+	var target interface{}
+	switch raw.Type {
+	case "Issue":
+		target = &api.ChangedIssue{}
+	case "Comment":
+		target = &api.ChangedComment{}
+	case "Project":
+		target = &api.ChangedProject{}
+	default:
+		target = &map[string]interface{}{}
+	}
+
+	if len(raw.Data) > 0 {
+		if err := json.Unmarshal(raw.Data, target); err != nil {
+			return nil, fmt.Errorf("invalid %s data: %w", raw.Type, err)
+		}
+	}
+	event.Data = target
+
+	return &event, nil
+}
+
+// Sign returns the Linear-Signature value for a body
+func Sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SamplePayload builds a minimal delivery body for a resource type, used to
+// test webhook endpoints
+func SamplePayload(resourceType, webhookID string, now time.Time) ([]byte, error) {
+	stamp := now.UTC().Format(time.RFC3339)
+
+	var data interface{}
+	switch resourceType {
+	case "Issue":
+		data = api.ChangedIssue{
+			ID:         "00000000-0000-0000-0000-000000000001",
+			Identifier: "TEST-1",
+			Title:      "Sample issue from linear webhook test",
+			State:      api.IssueState{ID: "00000000-0000-0000-0000-000000000002", Name: "Todo", Type: "unstarted"},
+			Labels:     []api.IssueLabel{},
+			CreatedAt:  stamp,
+			UpdatedAt:  stamp,
+		}
+	case "Comment":
+		data = api.ChangedComment{
+			ID:        "00000000-0000-0000-0000-000000000003",
+			Body:      "Sample comment from linear webhook test",
+			Issue:     &api.IssueParent{ID: "00000000-0000-0000-0000-000000000001", Identifier: "TEST-1", Title: "Sample issue"},
+			CreatedAt: stamp,
+			UpdatedAt: stamp,
+		}
+	case "Project":
+		data = api.ChangedProject{
+			ID:        "00000000-0000-0000-0000-000000000004",
+			Name:      "Sample project from linear webhook test",
+			State:     "planned",
+			CreatedAt: stamp,
+			UpdatedAt: stamp,
+		}
+	default:
+		data = map[string]interface{}{
+			"id":        "00000000-0000-0000-0000-000000000005",
+			"createdAt": stamp,
+			"updatedAt": stamp,
+		}
+	}
+
+	return json.Marshal(Event{
+		Action:           "create",
+		Type:             resourceType,
+		CreatedAt:        stamp,
+		WebhookID:        webhookID,
+		WebhookTimestamp: now.UnixMilli(),
+		Data:             data,
+	})
+}
@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/juanbermudez/agent-linear-cli/internal/api"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"action":"create","type":"Issue"}`)
+	secret := "webhook-secret"
+	signature := Sign(body, secret)
+
+	tests := []struct {
+		name      string
+		body      []byte
+		signature string
+		secret    string
+		wantErr   string
+	}{
+		{
+			name:      "valid signature",
+			body:      body,
+			signature: signature,
+			secret:    secret,
+		},
+		{
+			name:      "upper case hex",
+			body:      body,
+			signature: strings.ToUpper(signature),
+			secret:    secret,
+		},
+		{
+			name:      "wrong secret",
+			body:      body,
+			signature: signature,
+			secret:    "other-secret",
+			wantErr:   "signature mismatch",
+		},
+		{
+			name:      "tampered body",
+			body:      []byte(`{"action":"remove","type":"Issue"}`),
+			signature: signature,
+			secret:    secret,
+			wantErr:   "signature mismatch",
+		},
+		{
+			name:      "malformed hex",
+			body:      body,
+			signature: "not-hex",
+			secret:    secret,
+			wantErr:   "malformed",
+		},
+		{
+			name:      "truncated signature",
+			body:      body,
+			signature: signature[:32],
+			secret:    secret,
+			wantErr:   "signature mismatch",
+		},
+		{
+			name:    "missing signature",
+			body:    body,
+			secret:  secret,
+			wantErr: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.body, tt.signature, tt.secret)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Verify() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Verify() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	now := time.UnixMilli(1767225600000)
+	tolerance := time.Minute
+
+	payload := func(resourceType string, sent time.Time) []byte {
+		return []byte(fmt.Sprintf(`{"action":"update","type":%q,"webhookTimestamp":%d,"data":{"id":"x-1","title":"T"}}`,
+			resourceType, sent.UnixMilli()))
+	}
+
+	tests := []struct {
+		name    string
+		body    []byte
+		wantErr string
+	}{
+		{
+			name: "current timestamp",
+			body: payload("Issue", now),
+		},
+		{
+			name: "inside tolerance",
+			body: payload("Issue", now.Add(-30*time.Second)),
+		},
+		{
+			name: "at the tolerance edge",
+			body: payload("Issue", now.Add(-tolerance)),
+		},
+		{
+			name: "at the tolerance edge in the future",
+			body: payload("Issue", now.Add(tolerance)),
+		},
+		{
+			name:    "just outside tolerance",
+			body:    payload("Issue", now.Add(-tolerance-time.Millisecond)),
+			wantErr: "outside tolerance",
+		},
+		{
+			name:    "far in the future",
+			body:    payload("Issue", now.Add(time.Hour)),
+			wantErr: "outside tolerance",
+		},
+		{
+			name:    "missing timestamp",
+			body:    []byte(`{"action":"create","type":"Issue","data":{}}`),
+			wantErr: "missing webhookTimestamp",
+		},
+		{
+			name:    "invalid JSON",
+			body:    []byte(`{"action":`),
+			wantErr: "invalid payload",
+		},
+		{
+			name:    "data of the wrong shape",
+			body:    []byte(fmt.Sprintf(`{"type":"Issue","webhookTimestamp":%d,"data":[]}`, now.UnixMilli())),
+			wantErr: "invalid Issue data",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := Decode(tt.body, now, tolerance)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Decode() = %v, want nil", err)
+				}
+				if event.Action != "update" || event.Type != "Issue" {
+					t.Errorf("Decode() = %+v", event)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Decode() = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeTypes(t *testing.T) {
+	now := time.Now()
+
+	for _, resourceType := range []string{"Issue", "Comment", "Project", "Cycle"} {
+		t.Run(resourceType, func(t *testing.T) {
+			body, err := SamplePayload(resourceType, "hook-1", now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			event, err := Decode(body, now, DefaultTolerance)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ok bool
+			switch resourceType {
+			case "Issue":
+				var issue *api.ChangedIssue
+				issue, ok = event.Data.(*api.ChangedIssue)
+				ok = ok && issue.Identifier == "TEST-1"
+			case "Comment":
+				_, ok = event.Data.(*api.ChangedComment)
+			case "Project":
+				_, ok = event.Data.(*api.ChangedProject)
+			default:
+				_, ok = event.Data.(*map[string]interface{})
+			}
+			if !ok {
+				t.Errorf("Data = %#v", event.Data)
+			}
+			if event.WebhookID != "hook-1" {
+				t.Errorf("WebhookID = %q, want hook-1", event.WebhookID)
+			}
+		})
+	}
+}